@@ -129,6 +129,7 @@ SELECT
   r.conname::varchar AS foreign_key_name,
   b.attname::varchar AS column_name,
   i.relname::varchar AS ref_index_name,
+  m.nspname::varchar AS ref_schema_name,
   c.relname::varchar AS ref_table_name,
   d.attname::varchar AS ref_column_name,
   0::integer AS key_id,
@@ -141,6 +142,7 @@ FROM pg_constraint r
   JOIN ONLY pg_attribute b ON b.attisdropped = false AND b.attnum = ANY(r.conkey) AND b.attrelid = r.conrelid
   JOIN ONLY pg_class i on i.oid = r.conindid
   JOIN ONLY pg_class c on c.oid = r.confrelid
+  JOIN ONLY pg_namespace m ON m.oid = c.relnamespace
   JOIN ONLY pg_attribute d ON d.attisdropped = false AND d.attnum = ANY(r.confkey) AND d.attrelid = r.confrelid
   JOIN ONLY pg_namespace n ON n.oid = r.connamespace
 WHERE r.contype = 'f' AND n.nspname = %%schema string%% AND a.relname = %%table string%%
@@ -267,6 +269,7 @@ $XOBIN $MYDB -a -N -M -B -T ForeignKey -F MyTableForeignKeys -o $DEST $EXTRA <<
 SELECT
   constraint_name AS foreign_key_name,
   column_name AS column_name,
+  referenced_table_schema AS ref_schema_name,
   referenced_table_name AS ref_table_name,
   referenced_column_name AS ref_column_name
 FROM information_schema.key_column_usage
@@ -377,6 +380,7 @@ $XOBIN $MSDB -a -N -M -B -T ForeignKey -F MsTableForeignKeys -o $DEST $EXTRA <<
 SELECT
   f.name AS foreign_key_name,
   c.name AS column_name,
+  SCHEMA_NAME(o.uid) AS ref_schema_name,
   o.name AS ref_table_name,
   x.name AS ref_column_name
 FROM sysobjects f
@@ -466,6 +470,7 @@ SELECT
   LOWER(a.constraint_name) AS foreign_key_name,
   LOWER(a.column_name) AS column_name,
   LOWER(r.constraint_name) AS ref_index_name,
+  LOWER(r.owner) AS ref_schema_name,
   LOWER(r.table_name) AS ref_table_name
 FROM all_cons_columns a
   JOIN all_constraints c ON a.owner = c.owner AND a.constraint_name = c.constraint_name
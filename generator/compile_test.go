@@ -0,0 +1,209 @@
+package generator_test
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sort"
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/generator"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+// compileSnapshot returns a snapshot of the loader type, with the int, text
+// and time types and the table relkind of the loader, exercising the foreign
+// key, index, many-to-many and upsert code of the templates. With all, the
+// authors and books have the soft delete, created, updated and version
+// columns.
+func compileSnapshot(loaderType, intType, textType, timeType, relkind string, all bool) *generator.Snapshot {
+	s := &generator.Snapshot{
+		Version:    generator.SnapshotVersion,
+		LoaderType: loaderType,
+		Schemas:    []string{"public"},
+		Tables: []*generator.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "authors"},
+				Columns: []*models.Column{
+					{ColumnName: "author_id", DataType: intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "name", DataType: textType, NotNull: true},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "books"},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "author_id", DataType: intType, NotNull: true},
+					{FieldOrdinal: 2, ColumnName: "title", DataType: textType, NotNull: true},
+				},
+				ForeignKeys: []*models.ForeignKey{
+					{ForeignKeyName: "books_author_id_fkey", ColumnName: "author_id", RefTableName: "authors", RefColumnName: "author_id"},
+				},
+				Indexes: []*generator.SnapshotIndex{
+					{
+						Index:   &models.Index{IndexName: "books_author_idx"},
+						Columns: []*models.IndexColumn{{ColumnName: "author_id"}},
+					},
+					{
+						Index:   &models.Index{IndexName: "books_title_idx", IsUnique: true},
+						Columns: []*models.IndexColumn{{ColumnName: "title"}},
+					},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "tags", ManualPk: true},
+				Columns: []*models.Column{
+					{ColumnName: "tag_id", DataType: intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "slug", DataType: textType, NotNull: true},
+				},
+				Indexes: []*generator.SnapshotIndex{
+					{
+						Index:   &models.Index{IndexName: "tags_slug_idx", IsUnique: true},
+						Columns: []*models.IndexColumn{{ColumnName: "slug"}},
+					},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "employees"},
+				Columns: []*models.Column{
+					{ColumnName: "employee_id", DataType: intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "manager_id", DataType: intType},
+				},
+				ForeignKeys: []*models.ForeignKey{
+					{ForeignKeyName: "employees_manager_id_fkey", ColumnName: "manager_id", RefTableName: "employees", RefColumnName: "employee_id"},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "book_labels", ManualPk: true},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "tag_id", DataType: intType, NotNull: true, IsPrimaryKey: true},
+				},
+				ForeignKeys: []*models.ForeignKey{
+					{ForeignKeyName: "book_labels_book_id_fkey", ColumnName: "book_id", RefTableName: "books", RefColumnName: "book_id"},
+					{ForeignKeyName: "book_labels_tag_id_fkey", ColumnName: "tag_id", RefTableName: "tags", RefColumnName: "tag_id"},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "mentorships", ManualPk: true},
+				Columns: []*models.Column{
+					{ColumnName: "employee_id", DataType: intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "mentor_id", DataType: intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "note", DataType: textType},
+				},
+				ForeignKeys: []*models.ForeignKey{
+					{ForeignKeyName: "mentorships_employee_id_fkey", ColumnName: "employee_id", RefTableName: "employees", RefColumnName: "employee_id"},
+					{ForeignKeyName: "mentorships_mentor_id_fkey", ColumnName: "mentor_id", RefTableName: "employees", RefColumnName: "employee_id"},
+				},
+			},
+		},
+	}
+
+	for _, t := range s.Tables {
+		t.Schema, t.Relkind = "public", relkind
+
+		// oracle lists the primary key indexes, which the other loaders create
+		// when missing
+		if loaderType == "ora" {
+			ix := &generator.SnapshotIndex{
+				Index: &models.Index{IndexName: t.Table.TableName + "_pkey", IsUnique: true, IsPrimary: true},
+			}
+			for _, c := range t.Columns {
+				if c.IsPrimaryKey {
+					ix.Columns = append(ix.Columns, &models.IndexColumn{SeqNo: len(ix.Columns) + 1, ColumnName: c.ColumnName})
+				}
+			}
+			t.Indexes = append(t.Indexes, ix)
+		}
+	}
+	if all {
+		for _, t := range s.Tables[:2] {
+			n := len(t.Columns)
+			t.Columns = append(t.Columns,
+				&models.Column{FieldOrdinal: n, ColumnName: "deleted_at", DataType: timeType},
+				&models.Column{FieldOrdinal: n + 1, ColumnName: "created_at", DataType: timeType, NotNull: true},
+				&models.Column{FieldOrdinal: n + 2, ColumnName: "updated_at", DataType: timeType},
+				&models.Column{FieldOrdinal: n + 3, ColumnName: "lock_version", DataType: intType, NotNull: true},
+			)
+		}
+	}
+
+	return s
+}
+
+func Test_Compile(t *testing.T) {
+	tests := []struct {
+		loaderType string
+		intType    string
+		textType   string
+		timeType   string
+		relkind    string
+	}{
+		{"postgres", "integer", "text", "timestamp with time zone", "r"},
+		{"mysql", "int", "text", "datetime", "BASE TABLE"},
+		{"sqlite3", "INTEGER", "TEXT", "DATETIME", "table"},
+		{"mssql", "int", "varchar", "datetime", "U"},
+		{"ora", "integer", "varchar2", "timestamp", "TABLE"},
+	}
+
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "source", nil)
+
+	for _, tt := range tests {
+		for _, all := range []bool{false, true} {
+			opts := generator.NewOptions()
+			opts.Out = "models"
+			if all {
+				opts.Context = true
+				opts.PartialUpdates = true
+				opts.SoftDeleteColumn = "deleted_at"
+				opts.CreatedColumns = []string{"created_at"}
+				opts.UpdatedColumns = []string{"updated_at"}
+				opts.VersionColumn = "lock_version"
+				err := opts.ManyToManyMode.UnmarshalText([]byte("all"))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			sink := generator.MapSink{}
+			g, err := generator.New(opts, sink)
+			if err != nil {
+				t.Fatalf("%s (all: %t): %v", tt.loaderType, all, err)
+			}
+			err = g.SetSnapshot(compileSnapshot(tt.loaderType, tt.intType, tt.textType, tt.timeType, tt.relkind, all))
+			if err != nil {
+				t.Fatalf("%s (all: %t): %v", tt.loaderType, all, err)
+			}
+			err = g.Generate()
+			if err != nil {
+				t.Fatalf("%s (all: %t): %v", tt.loaderType, all, err)
+			}
+
+			names := []string{}
+			for name := range sink {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			files := []*ast.File{}
+			for _, name := range names {
+				f, err := parser.ParseFile(fset, name, sink[name], 0)
+				if err != nil {
+					t.Fatalf("%s (all: %t): %v", tt.loaderType, all, err)
+				}
+				files = append(files, f)
+			}
+
+			conf := types.Config{
+				Importer: imp,
+				Error: func(err error) {
+					t.Errorf("%s (all: %t): %v", tt.loaderType, all, err)
+				},
+			}
+			conf.Check("models", fset, files, nil)
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package generator_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/generator"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func testSnapshot() *generator.Snapshot {
+	return &generator.Snapshot{
+		Version:    generator.SnapshotVersion,
+		LoaderType: "sqlite3",
+		Schemas:    []string{""},
+		Tables: []*generator.SnapshotTable{
+			{
+				Relkind: "table",
+				Table:   &models.Table{TableName: "authors"},
+				Columns: []*models.Column{
+					{ColumnName: "author_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "name", DataType: "TEXT", NotNull: true},
+				},
+			},
+			{
+				Relkind: "table",
+				Table:   &models.Table{TableName: "books"},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "author_id", DataType: "INTEGER", NotNull: true},
+					{FieldOrdinal: 2, ColumnName: "title", DataType: "TEXT", NotNull: true},
+				},
+				ForeignKeys: []*models.ForeignKey{
+					{ForeignKeyName: "books_author_id_fkey", ColumnName: "author_id", RefTableName: "authors", RefColumnName: "author_id"},
+				},
+			},
+		},
+	}
+}
+
+func Test_Generate(t *testing.T) {
+	opts := generator.NewOptions()
+	opts.Out = "models"
+
+	sink := generator.MapSink{}
+	g, err := generator.New(opts, sink)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.SetSnapshot(testSnapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"models/author.xo.go", "models/book.xo.go", "models/xo_db.xo.go"} {
+		if !bytes.Contains(sink[name], []byte("package models\n")) {
+			t.Errorf("expected %s to be generated, got:\n%s", name, sink[name])
+		}
+	}
+
+	first := generator.MapSink{}
+	for k, v := range sink {
+		first[k] = v
+	}
+
+	// the options are copied, so later changes are not used
+	opts.Suffix = ".gen.go"
+
+	// another generator with other options does not affect the first
+	other := generator.NewOptions()
+	other.Out = "models"
+	other.StructTags = []generator.StructTag{{Name: "db"}}
+	g2, err := generator.New(other, generator.MapSink{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g2.SetSnapshot(testSnapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g2.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// running again gives the same output
+	err = g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sink) != len(first) {
+		t.Fatalf("expected %d files, got: %d", len(first), len(sink))
+	}
+	for name, buf := range sink {
+		if !bytes.Equal(buf, first[name]) {
+			t.Errorf("expected %s to be unchanged, got:\n%s\n\nexp:\n%s", name, buf, first[name])
+		}
+	}
+}
+
+func Test_Check(t *testing.T) {
+	opts := generator.NewOptions()
+	opts.Out = "models"
+
+	sink := generator.MapSink{}
+	g, err := generator.New(opts, sink)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.SetSnapshot(testSnapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	n, err := g.Check(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 || buf.Len() != 0 {
+		t.Fatalf("expected generated files to be up to date, got %d:\n%s", n, buf.String())
+	}
+
+	// outdated files are reported, but not the files of other runs or hand
+	// written files
+	sink["models/book.xo.go"] = append(sink["models/book.xo.go"], "// edited\n"...)
+	sink["models/removed.xo.go"] = []byte("package models\n\n// Code generated by xo. DO NOT EDIT.\n")
+	sink["models/custom.xo.go"] = []byte("package models\n")
+	n, err = g.Check(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 file to be out of date, got %d:\n%s", n, buf.String())
+	}
+	if strings.Contains(buf.String(), "/dev/null") {
+		t.Errorf("expected no removed file in diff, got:\n%s", buf.String())
+	}
+
+	// stale generated files are reported when asked
+	opts.CheckStale = true
+	g, err = generator.New(opts, sink)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.SetSnapshot(testSnapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	n, err = g.Check(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("expected 2 files to be out of date, got %d:\n%s", n, buf.String())
+	}
+	if !strings.Contains(buf.String(), "--- models/removed.xo.go\n+++ /dev/null") {
+		t.Errorf("expected removed file in diff, got:\n%s", buf.String())
+	}
+}
+
+func Test_GenerateWithoutSource(t *testing.T) {
+	g, err := generator.New(generator.NewOptions(), generator.MapSink{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = g.Generate(); err == nil {
+		t.Fatalf("expected error generating without a database or snapshot")
+	}
+}
+
+func Test_ExtraTemplates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gendal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for name, src := range map[string]string{
+		"repository.go.tpl": `// {{ .Name }}Repository stores {{ .Name }}s.
+type {{ .Name }}Repository struct {
+	db models.XODB
+}
+`,
+		"fixtures.go.tpl": `// Fixtures is generated once for package {{ .Package }}.
+var Fixtures = []string{}
+`,
+	} {
+		err = ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0666)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	opts := generator.NewOptions()
+	opts.Out = "models"
+	opts.TemplatePath = dir
+	opts.ExtraTemplates = []generator.ExtraTemplate{
+		{
+			Name:     "repository",
+			Filename: "repository/{snake}.go",
+			Package:  "repository",
+			Imports:  []string{"example.com/app/models"},
+		},
+		{
+			Name: "fixtures",
+			For:  "package",
+		},
+	}
+
+	sink := generator.MapSink{}
+	g, err := generator.New(opts, sink)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.SetSnapshot(testSnapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, exp := range map[string][]string{
+		"models/repository/author.go": {"package repository\n", `"example.com/app/models"`, "type AuthorRepository struct"},
+		"models/repository/book.go":   {"package repository\n", "type BookRepository struct"},
+		"models/fixtures.xo.go":       {"package models\n", "generated once for package models."},
+	} {
+		for _, s := range exp {
+			if !bytes.Contains(sink[name], []byte(s)) {
+				t.Errorf("expected %s to contain %q, got:\n%s", name, s, sink[name])
+			}
+		}
+	}
+}
+
+func Test_Context(t *testing.T) {
+	tests := []struct {
+		desc    string
+		context bool
+		exp     map[string][]string
+	}{
+		{
+			desc: "without context",
+			exp: map[string][]string{
+				"models/xo_db.xo.go": {"\tExec(string, ...interface{}) (sql.Result, error)"},
+				"models/book.xo.go": {
+					"func (b *Book) Insert(db XODB) error {",
+					"_, err = db.Exec(sqlstr, b.BookID)",
+					"return AuthorByAuthorID(db, b.AuthorID)",
+					"func BookByBookID(db XODB, bookID int) (*Book, error) {",
+				},
+			},
+		},
+		{
+			desc:    "with context",
+			context: true,
+			exp: map[string][]string{
+				"models/xo_db.xo.go": {"\"context\"", "\tExecContext(context.Context, string, ...interface{}) (sql.Result, error)"},
+				"models/book.xo.go": {
+					"\"context\"",
+					"func (b *Book) Insert(ctx context.Context, db XODB) error {",
+					"return b.Update(ctx, db)",
+					"_, err = db.ExecContext(ctx, sqlstr, b.BookID)",
+					"return AuthorByAuthorID(ctx, db, b.AuthorID)",
+					"func BookByBookID(ctx context.Context, db XODB, bookID int) (*Book, error) {",
+					"err = db.QueryRowContext(ctx, sqlstr, bookID)",
+				},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		opts := generator.NewOptions()
+		opts.Out = "models"
+		opts.Context = tt.context
+
+		sink := generator.MapSink{}
+		g, err := generator.New(opts, sink)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = g.SetSnapshot(testSnapshot())
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = g.Generate()
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		for name, exp := range tt.exp {
+			for _, s := range exp {
+				if !bytes.Contains(sink[name], []byte(s)) {
+					t.Errorf("test #%d: %s\n\texpected %s to contain %q, got:\n%s", i+1, tt.desc, name, s, sink[name])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/scanner"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/turnkey-commerce/gendal/internal"
+)
+
+// generatedHeader is the comment marking the files generated by the built-in
+// package template.
+const generatedHeader = "// Code generated by xo. DO NOT EDIT."
+
+// segment is a rendered template within a generated file.
+type segment struct {
+	template string // template name
+	line     int    // first line of the segment in the file
+}
+
+// output collects the generated definitions of a run into files.
+type output struct {
+	args *internal.ArgType
+	sink Sink
+
+	// buffers is a map of filenames to the in-memory contents of the
+	// generated files. A nil buffer marks a file that is left untouched by
+	// generation.
+	buffers map[string]*bytes.Buffer
+
+	// segments is a map of filenames to the rendered templates they contain,
+	// in order, and is used to locate errors in the generated code.
+	segments map[string][]segment
+}
+
+// newOutput creates the output for the generated definitions in args.
+func newOutput(args *internal.ArgType, sink Sink) *output {
+	return &output{
+		args:     args,
+		sink:     sink,
+		buffers:  map[string]*bytes.Buffer{},
+		segments: map[string][]segment{},
+	}
+}
+
+// filename builds the filepath for the TBuf.
+func (o *output) filename(t *internal.TBuf) string {
+	var filename = strings.ToLower(t.Name) + o.args.Suffix
+	if t.Filename != "" {
+		// extra templates have their own files
+		filename = t.Filename
+	} else if o.args.SingleFile {
+		filename = o.args.Filename
+	}
+	return path.Join(o.args.Path, filename)
+}
+
+// writeHeader writes the build tags and package header for a generated file
+// of package pkg, or of the package of the generated code when empty.
+func (o *output) writeHeader(w io.Writer, pkg string) error {
+	// add build tags
+	if o.args.Tags != "" {
+		io.WriteString(w, `// +build `+o.args.Tags+"\n\n")
+	}
+
+	args := o.args
+	if pkg != "" && pkg != args.Package {
+		a := *args
+		a.Package = pkg
+		args = &a
+	}
+
+	// execute
+	return o.args.TemplateSet().Execute(w, "xo_package.go.tpl", args)
+}
+
+// getBuffer builds the filepath from the TBuf information, and retrieves the
+// in-memory buffer from buffers. If the built filename is not already defined,
+// then the buffer is created with either the package header or, when
+// appending, the existing contents of the file.
+func (o *output) getBuffer(t *internal.TBuf) (*bytes.Buffer, error) {
+	// determine filename
+	filename := o.filename(t)
+
+	// lookup buffer
+	buf, ok := o.buffers[filename]
+	if ok {
+		return buf, nil
+	}
+
+	// read file to determine if file already exists
+	src, err := o.sink.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	exists := err == nil
+
+	// skip, as an existing XO file is never overwritten
+	if t.TemplateType == internal.XOTemplate && exists {
+		o.buffers[filename] = nil
+		return nil, nil
+	}
+
+	buf = new(bytes.Buffer)
+	if exists && o.args.Append {
+		// file exists so append to its contents
+		buf.Write(src)
+	} else {
+		// add package header
+		o.segments[filename] = []segment{{"xo_package.go.tpl", 1}}
+		err = o.writeHeader(buf, t.Package)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// store buffer
+	o.buffers[filename] = buf
+
+	return buf, nil
+}
+
+// writeSegments writes the generated definitions in order to their buffers.
+func (o *output) writeSegments() error {
+	out := internal.TBufSlice(o.args.Generated)
+
+	// sort segments, keeping the generated order of equal segments
+	sort.Stable(out)
+
+	// loop, writing in order
+	for _, t := range out {
+		// skip when in append and type is XO
+		if o.args.Append && t.TemplateType == internal.XOTemplate {
+			continue
+		}
+
+		// check if generated template is only whitespace/empty
+		bufStr := strings.TrimSpace(t.Buf.String())
+		if len(bufStr) == 0 {
+			continue
+		}
+
+		// get buffer
+		buf, err := o.getBuffer(&t)
+		if err != nil {
+			return err
+		}
+
+		// should only be nil when type == xo
+		if buf == nil {
+			continue
+		}
+
+		// write segment
+		if !o.args.Append || (t.TemplateType != internal.TypeTemplate && t.TemplateType != internal.QueryTypeTemplate) {
+			filename := o.filename(&t)
+			o.segments[filename] = append(o.segments[filename], segment{
+				template: t.Template,
+				line:     bytes.Count(buf.Bytes(), []byte("\n")) + 1,
+			})
+
+			_, err = t.Buf.WriteTo(buf)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatFile formats the generated contents of filename. When the contents
+// are not valid Go, the error names the template that rendered the offending
+// line.
+func (o *output) formatFile(filename string, src []byte) ([]byte, error) {
+	out, err := internal.FormatSource(filename, src, o.args.TypeImports)
+	if errs, ok := err.(scanner.ErrorList); ok && len(errs) != 0 {
+		pos := errs[0].Pos
+
+		// find the segment containing the error
+		var s *segment
+		for i := range o.segments[filename] {
+			if o.segments[filename][i].line > pos.Line {
+				break
+			}
+			s = &o.segments[filename][i]
+		}
+		if s == nil {
+			return nil, errs[0]
+		}
+
+		return nil, fmt.Errorf("%s:%d: template %s produced invalid Go at line %d of its output: %s", filename, pos.Line, s.template, pos.Line-s.line+1, errs[0].Msg)
+	}
+
+	return out, err
+}
+
+// formatBuffers formats the generated files in buffers, returning the sorted
+// filenames and a map of filenames to their formatted contents.
+func (o *output) formatBuffers() ([]string, map[string][]byte, error) {
+	filenames := []string{}
+	for k, buf := range o.buffers {
+		if buf != nil {
+			filenames = append(filenames, k)
+		}
+	}
+	sort.Strings(filenames)
+
+	contents := make(map[string][]byte, len(filenames))
+	for _, k := range filenames {
+		src, err := o.formatFile(k, o.buffers[k].Bytes())
+		if err != nil {
+			return nil, nil, err
+		}
+		contents[k] = src
+	}
+
+	return filenames, contents, nil
+}
+
+// writeTypes writes the generated definitions to the sink.
+func (o *output) writeTypes() error {
+	var err error
+
+	err = o.writeSegments()
+	if err != nil {
+		return err
+	}
+
+	// format all files before writing any, so that a template error does not
+	// leave partial output behind
+	filenames, contents, err := o.formatBuffers()
+	if err != nil {
+		return err
+	}
+
+	for _, k := range filenames {
+		err = o.sink.WriteFile(k, contents[k])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkTypes renders the generated definitions in memory and compares them
+// with the files in the sink, writing a unified diff for each file that
+// differs to w. When CheckStale is toggled, generated files in the sink that
+// would no longer be generated are reported as well. The number of files that
+// differ is returned.
+func (o *output) checkTypes(w io.Writer) (int, error) {
+	var err error
+
+	if o.args.Append {
+		return 0, errors.New("check mode cannot be used when appending to existing files")
+	}
+
+	err = o.writeSegments()
+	if err != nil {
+		return 0, err
+	}
+
+	filenames, contents, err := o.formatBuffers()
+	if err != nil {
+		return 0, err
+	}
+
+	// include existing generated files that are no longer generated, which
+	// are only known to be stale when asked, as other runs (ie, query mode)
+	// may generate files in the same path
+	if o.args.CheckStale && !o.args.SingleFile {
+		existing, err := o.sink.Glob(path.Join(o.args.Path, "*"+o.args.Suffix))
+		if err != nil {
+			return 0, err
+		}
+		for _, k := range existing {
+			if _, ok := o.buffers[k]; ok {
+				continue
+			}
+
+			// skip files not generated by gendal (ie, hand written files)
+			buf, err := o.sink.ReadFile(k)
+			if err != nil {
+				return 0, err
+			}
+			if !bytes.Contains(buf, []byte(generatedHeader)) {
+				continue
+			}
+
+			filenames = append(filenames, k)
+		}
+	}
+	sort.Strings(filenames)
+
+	// compare
+	n := 0
+	for _, k := range filenames {
+		expected, ok := contents[k]
+
+		actual, err := o.sink.ReadFile(k)
+		if err != nil && !os.IsNotExist(err) {
+			return 0, err
+		}
+
+		// label missing files the same way diff(1) does
+		from, to := k, k
+		if os.IsNotExist(err) {
+			from = "/dev/null"
+		} else if !ok {
+			to = "/dev/null"
+		}
+
+		d := internal.UnifiedDiff(from, to, actual, expected)
+		if d != "" {
+			io.WriteString(w, d)
+			n++
+		}
+	}
+
+	return n, nil
+}
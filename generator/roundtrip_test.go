@@ -0,0 +1,520 @@
+package generator_test
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/generator"
+)
+
+// roundTripDB is the source of the openDB func of the round trip packages,
+// without the schema.
+const roundTripDB = `package models
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// openDB opens a new in-memory database created from the schema.
+func openDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// each connection has its own in-memory database
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+const schema = `
+
+// roundTrip generates the package of the sqlite3 schema with the opts, and
+// runs the tests of the test source, a file of the package without its package
+// clause, with go test. The tests open a database created from the schema with
+// openDB.
+func roundTrip(t *testing.T, opts *generator.Options, schema, test string) {
+	if testing.Short() {
+		t.Skip("skipping round trip in short mode")
+	}
+
+	// the package is generated in the module, to build with its dependencies
+	err := os.MkdirAll("testdata", 0777)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("testdata", "roundtrip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := sql.Open("sqlite3", filepath.Join(dir, "schema.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	_, err = db.Exec(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts.Out = dir
+	opts.Package = "models"
+	g, err := generator.New(opts, generator.FileSink{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.SetDB("sqlite3", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, "db_test.go"), []byte(roundTripDB+strconv.Quote(schema)+"\n"), 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "roundtrip_test.go"), []byte("package models\n"+test), 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("go", "test", "-count=1", ".")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+}
+
+func Test_RoundTripUpsert(t *testing.T) {
+	roundTrip(t, generator.NewOptions(), `
+CREATE TABLE authors (
+	author_id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL
+);
+CREATE TABLE tags (
+	tag_id INT NOT NULL PRIMARY KEY,
+	slug TEXT NOT NULL UNIQUE,
+	label TEXT NOT NULL
+);
+`, `
+import "testing"
+
+func TestUpsert(t *testing.T) {
+	db := openDB(t)
+
+	a := &Author{AuthorID: 1, Name: "Ursula"}
+	if err := a.Upsert(db); err != nil {
+		t.Fatal(err)
+	}
+	a = &Author{AuthorID: 1, Name: "Ursula K. Le Guin"}
+	if err := a.Upsert(db); err != nil {
+		t.Fatal(err)
+	}
+	if !a.Exists() {
+		t.Errorf("expected upserted author to exist")
+	}
+
+	b, err := AuthorByAuthorID(db, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Name != "Ursula K. Le Guin" {
+		t.Errorf("expected updated name, got: %q", b.Name)
+	}
+}
+
+func TestUpsertByIndex(t *testing.T) {
+	db := openDB(t)
+
+	tag := &Tag{TagID: 1, Slug: "go", Label: "Go"}
+	if err := tag.Insert(db); err != nil {
+		t.Fatal(err)
+	}
+
+	// the row of the conflicting slug is updated, keeping its key
+	tag = &Tag{TagID: 2, Slug: "go", Label: "Golang"}
+	if err := tag.UpsertBySlug(db); err != nil {
+		t.Fatal(err)
+	}
+	if tag.TagID != 1 {
+		t.Errorf("expected key of the existing row, got: %d", tag.TagID)
+	}
+
+	other, err := TagByTagID(db, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Label != "Golang" {
+		t.Errorf("expected updated label, got: %q", other.Label)
+	}
+	if _, err = TagByTagID(db, 2); err == nil {
+		t.Errorf("expected no row inserted with the new key")
+	}
+}
+`)
+}
+
+func Test_RoundTripInsertBatch(t *testing.T) {
+	roundTrip(t, generator.NewOptions(), `
+CREATE TABLE authors (
+	author_id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL
+);
+CREATE TABLE tags (
+	tag_id INT NOT NULL PRIMARY KEY,
+	label TEXT NOT NULL
+);
+`, `
+import (
+	"fmt"
+	"testing"
+)
+
+func TestInsertBatch(t *testing.T) {
+	db := openDB(t)
+
+	// more rows than fit in a single insert
+	var as []*Author
+	for i := 0; i < 1200; i++ {
+		as = append(as, &Author{Name: fmt.Sprintf("author %d", i)})
+	}
+	if err := InsertAuthors(db, as); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, a := range as {
+		if !a.Exists() {
+			t.Fatalf("expected author %d to exist", i)
+		}
+		b, err := AuthorByAuthorID(db, a.AuthorID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b.Name != a.Name {
+			t.Fatalf("expected author %d to have key of %q, got: %q", a.AuthorID, a.Name, b.Name)
+		}
+	}
+
+	if err := InsertAuthors(db, as[:1]); err == nil {
+		t.Errorf("expected error inserting an existing author")
+	}
+}
+
+func TestInsertBatchManualKeys(t *testing.T) {
+	db := openDB(t)
+
+	ts := []*Tag{{TagID: 10, Label: "go"}, {TagID: 20, Label: "sql"}}
+	if err := InsertTags(db, ts); err != nil {
+		t.Fatal(err)
+	}
+
+	tag, err := TagByTagID(db, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag.Label != "sql" {
+		t.Errorf("expected label of tag 20, got: %q", tag.Label)
+	}
+}
+`)
+}
+
+func Test_RoundTripList(t *testing.T) {
+	roundTrip(t, generator.NewOptions(), `
+CREATE TABLE books (
+	book_id INTEGER PRIMARY KEY,
+	title TEXT NOT NULL UNIQUE
+);
+`, `
+import (
+	"reflect"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	db := openDB(t)
+
+	for _, title := range []string{"e", "b", "g", "a", "f", "c", "d"} {
+		if err := (&Book{Title: title}).Insert(db); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		opts ListBooksOpts
+		exp  []string
+	}{
+		{ListBooksOpts{}, []string{"e", "b", "g", "a", "f", "c", "d"}},
+		{ListBooksOpts{Desc: true}, []string{"d", "c", "f", "a", "g", "b", "e"}},
+		{ListBooksOpts{Order: ListBooksByTitle}, []string{"a", "b", "c", "d", "e", "f", "g"}},
+		{ListBooksOpts{Order: ListBooksByTitle, Desc: true}, []string{"g", "f", "e", "d", "c", "b", "a"}},
+	}
+	for i, tt := range tests {
+		// keyset pagination
+		var titles []string
+		opts := tt.opts
+		opts.Limit = 3
+		for n := 0; ; n++ {
+			bs, cursor, err := ListBooks(db, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, b := range bs {
+				titles = append(titles, b.Title)
+			}
+			if cursor == nil {
+				break
+			}
+			if n == 3 {
+				t.Fatalf("test #%d: expected the last page after 3 pages", i+1)
+			}
+			opts.After = cursor
+		}
+		if !reflect.DeepEqual(titles, tt.exp) {
+			t.Errorf("test #%d: expected keyset pages %v, got: %v", i+1, tt.exp, titles)
+		}
+
+		// offset pagination
+		opts = tt.opts
+		opts.Offset = 2
+		bs, _, err := ListBooks(db, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		titles = nil
+		for _, b := range bs {
+			titles = append(titles, b.Title)
+		}
+		if !reflect.DeepEqual(titles, tt.exp[2:]) {
+			t.Errorf("test #%d: expected offset page %v, got: %v", i+1, tt.exp[2:], titles)
+		}
+	}
+}
+`)
+}
+
+func Test_RoundTripSoftDelete(t *testing.T) {
+	for _, marker := range []string{"removed TEXT", "removed BOOLEAN NOT NULL DEFAULT 0"} {
+		opts := generator.NewOptions()
+		opts.SoftDeleteColumn = "removed"
+		roundTrip(t, opts, `
+CREATE TABLE authors (
+	author_id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	`+marker+`
+);
+`, `
+import (
+	"database/sql"
+	"testing"
+)
+
+func count(t *testing.T, q *AuthorQuery) int {
+	as, err := q.All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(as)
+}
+
+func TestSoftDelete(t *testing.T) {
+	db := openDB(t)
+
+	a := &Author{Name: "Ursula"}
+	if err := a.Insert(db); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&Author{Name: "Iain"}).Insert(db); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Delete(db); err != nil {
+		t.Fatal(err)
+	}
+	if !a.Deleted() || a.Exists() {
+		t.Errorf("expected author to be deleted")
+	}
+
+	// the soft deleted rows are only retrieved when asked
+	if _, err := AuthorByAuthorID(db, a.AuthorID); err != sql.ErrNoRows {
+		t.Errorf("expected no soft deleted author, got: %v", err)
+	}
+	b, err := AuthorByAuthorIDIncludingDeleted(db, a.AuthorID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !b.Deleted() {
+		t.Errorf("expected retrieved author to be deleted")
+	}
+	if n := count(t, Authors(db)); n != 1 {
+		t.Errorf("expected 1 author, got: %d", n)
+	}
+	if n := count(t, Authors(db).IncludingDeleted()); n != 2 {
+		t.Errorf("expected 2 authors including the deleted one, got: %d", n)
+	}
+	as, _, err := ListAuthors(db, ListAuthorsOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(as) != 1 {
+		t.Errorf("expected 1 listed author, got: %d", len(as))
+	}
+
+	if err := b.Restore(db); err != nil {
+		t.Fatal(err)
+	}
+	if b.Deleted() || !b.Exists() {
+		t.Errorf("expected author to be restored")
+	}
+	if _, err := AuthorByAuthorID(db, a.AuthorID); err != nil {
+		t.Errorf("expected restored author, got: %v", err)
+	}
+
+	if err := b.HardDelete(db); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := AuthorByAuthorIDIncludingDeleted(db, a.AuthorID); err != sql.ErrNoRows {
+		t.Errorf("expected no hard deleted author, got: %v", err)
+	}
+}
+`)
+	}
+}
+
+func Test_RoundTripTimestamps(t *testing.T) {
+	opts := generator.NewOptions()
+	opts.CreatedColumns = []string{"created_at"}
+	roundTrip(t, opts, `
+CREATE TABLE events (
+	event_id INT NOT NULL PRIMARY KEY,
+	created_at DATETIME NOT NULL UNIQUE
+);
+`, `
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestCreatedOnly(t *testing.T) {
+	db := openDB(t)
+
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	XONow = func() time.Time { return created }
+
+	e := &Event{EventID: 1}
+	if err := e.Upsert(db); err != nil {
+		t.Fatal(err)
+	}
+
+	XONow = func() time.Time { return created.Add(time.Hour) }
+	e = &Event{EventID: 1}
+	if err := e.Upsert(db); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := EventByEventID(db, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.CreatedAt.Time.Equal(created) {
+		t.Errorf("expected the kept created time %v, got: %v", created, f.CreatedAt.Time)
+	}
+
+	XONow = func() time.Time { return created }
+	e = &Event{EventID: 2}
+	if err := e.UpsertByCreatedAt(db); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := EventByEventID(db, 2); err != sql.ErrNoRows {
+		t.Errorf("expected the conflicting event to not be inserted, got: %v", err)
+	}
+}
+`)
+}
+
+func Test_RoundTripVersion(t *testing.T) {
+	opts := generator.NewOptions()
+	opts.VersionColumn = "lock_version"
+	roundTrip(t, opts, `
+CREATE TABLE authors (
+	author_id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	lock_version INTEGER NOT NULL DEFAULT 0
+);
+`, `
+import "testing"
+
+func TestVersion(t *testing.T) {
+	db := openDB(t)
+
+	a := &Author{Name: "Ursula"}
+	if err := a.Insert(db); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := AuthorByAuthorID(db, a.AuthorID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := AuthorByAuthorID(db, a.AuthorID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b.Name = "Ursula K. Le Guin"
+	if err := b.Update(db); err != nil {
+		t.Fatal(err)
+	}
+	if b.LockVersion != c.LockVersion+1 {
+		t.Errorf("expected version %d, got: %d", c.LockVersion+1, b.LockVersion)
+	}
+
+	// the other copy was loaded before the update
+	c.Name = "U. K. Le Guin"
+	if err := c.Update(db); err != ErrStaleObject {
+		t.Errorf("expected stale update, got: %v", err)
+	}
+	if err := c.Delete(db); err != ErrStaleObject {
+		t.Errorf("expected stale delete, got: %v", err)
+	}
+
+	d, err := AuthorByAuthorID(db, a.AuthorID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Name != b.Name || d.LockVersion != b.LockVersion {
+		t.Errorf("expected author of the first update, got: %q (version %d)", d.Name, d.LockVersion)
+	}
+
+	// the updated copy is current
+	b.Name = "Le Guin"
+	if err := b.Update(db); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Delete(db); err != nil {
+		t.Fatal(err)
+	}
+}
+`)
+}
@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+)
+
+// Sink is the destination of the generated files.
+type Sink interface {
+	// ReadFile returns the contents of the named file. When the file does not
+	// exist, the returned error satisfies os.IsNotExist.
+	ReadFile(name string) ([]byte, error)
+
+	// WriteFile writes the contents of the named file, replacing any existing
+	// contents.
+	WriteFile(name string, data []byte) error
+
+	// Glob returns the names of the existing files matching the pattern.
+	Glob(pattern string) ([]string, error)
+}
+
+// FileSink is a Sink writing to the filesystem.
+type FileSink struct{}
+
+// ReadFile satisfies the Sink interface.
+func (FileSink) ReadFile(name string) ([]byte, error) {
+	return ioutil.ReadFile(name)
+}
+
+// WriteFile satisfies the Sink interface. The directory of the file is created
+// when it does not exist.
+func (FileSink) WriteFile(name string, data []byte) error {
+	err := os.MkdirAll(filepath.Dir(name), 0777)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(name, data, 0666)
+}
+
+// Glob satisfies the Sink interface.
+func (FileSink) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
+
+// MapSink is a Sink keeping the generated files in memory, keyed by their
+// name.
+type MapSink map[string][]byte
+
+// ReadFile satisfies the Sink interface.
+func (m MapSink) ReadFile(name string) ([]byte, error) {
+	data, ok := m[name]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+
+	return append([]byte{}, data...), nil
+}
+
+// WriteFile satisfies the Sink interface.
+func (m MapSink) WriteFile(name string, data []byte) error {
+	m[name] = append([]byte{}, data...)
+	return nil
+}
+
+// Glob satisfies the Sink interface.
+func (m MapSink) Glob(pattern string) ([]string, error) {
+	names := []string{}
+	for name := range m {
+		ok, err := path.Match(pattern, name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
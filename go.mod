@@ -1,6 +1,6 @@
 module github.com/turnkey-commerce/gendal
 
-go 1.14
+go 1.16
 
 require (
 	github.com/alexflint/go-arg v1.3.0
@@ -13,5 +13,6 @@ require (
 	github.com/spf13/viper v1.7.0
 	github.com/xo/dburl v0.0.0-20200124232849-e9ec94f52bc3
 	github.com/xo/xoutil v0.0.0-20171112033149-46189f4026a5
+	golang.org/x/tools v0.0.0-20191112195655-aa38f8e97acc
 	gopkg.in/rana/ora.v4 v4.1.15
 )
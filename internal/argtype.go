@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/turnkey-commerce/gendal/loaders/postgrestypes"
@@ -18,8 +19,12 @@ type ArgType struct {
 	// It is no longer required since the value can also come from a config file.
 	DSN string `arg:"positional,help:data source name"`
 
-	// Schema is the name of the schema to query.
-	Schema string `arg:"-s,help:schema name to generate Go types for"`
+	// Schemas are the names of the schemas to query. Each may also be a comma
+	// separated list, and the flag accumulates them when repeated.
+	Schemas []string `arg:"--schema,-s,separate,help:schema names to generate Go types for" mapstructure:"schema"`
+
+	// Schema is the name of the schema being loaded.
+	Schema string `arg:"-" mapstructure:"-"`
 
 	// Out is the output path. If Out is a file, then that will be used as the
 	// path. If Out is a directory, then the output file will be
@@ -35,6 +40,22 @@ type ArgType struct {
 	// SingleFile when toggled changes behavior so that output is to one file.
 	SingleFile bool `arg:"--single-file,help:toggle single file output"`
 
+	// Snapshot is the file to write a JSON snapshot of the schema to, instead
+	// of generating code.
+	Snapshot string `arg:"--snapshot,help:write a JSON snapshot of the schema to file instead of generating code"`
+
+	// FromSnapshot is the snapshot file to generate code from, instead of
+	// loading the schema from a database.
+	FromSnapshot string `arg:"--from-snapshot,help:generate code from a schema snapshot file instead of a database"`
+
+	// Check toggles rendering the generated code in memory and comparing it
+	// with the existing files in the output path instead of writing them.
+	Check bool `arg:"--check,help:check that the generated files are up to date instead of writing them"`
+
+	// CheckStale toggles also reporting the generated files in the output
+	// path that would no longer be generated when checking.
+	CheckStale bool `arg:"--check-stale,help:also report the generated files in the output path that are no longer generated when checking"`
+
 	// Package is the name used to generate package headers. If not specified,
 	// the name of the output directory will be used instead.
 	Package string `arg:"-p,help:package name used in generated Go code"`
@@ -48,17 +69,32 @@ type ArgType struct {
 	// Uint32Type is the type to assign those discovered as uint32.
 	Uint32Type string `arg:"--uint32-type,-u,help:Go type to assign to unsigned integers"`
 
-	// IgnoreFields allows the user to specify field names which should not be
-	// handled by xo in the generated code.
-	IgnoreFields []string `arg:"--ignore-fields,help:fields to exclude from the generated Go code types"`
+	// IgnoreFields allows the user to specify field name patterns which
+	// should not be handled by xo in the generated code. A pattern may be
+	// scoped to a table (ie, 'audit_log.payload'). See Filter.
+	IgnoreFields []string `arg:"--ignore-fields,help:field patterns to exclude from the generated Go code types [ie: 'created_*' 'audit_log.payload']"`
+
+	// Tables allows the user to specify table name patterns to restrict the
+	// generated code to. When empty, all tables are generated. See Filter.
+	Tables []string `arg:"--tables,help:table patterns to include in the generated Go code types [ie: 'app_*' '/^app_/']"`
 
-	// IgnoreTables allows the user to specify table names which should not be
-	// handled by xo in the generated code.
-	IgnoreTables []string `arg:"--ignore-tables,help:tables to exclude from the generated Go code types"`
+	// IgnoreTables allows the user to specify table name patterns which
+	// should not be handled by xo in the generated code. See Filter.
+	IgnoreTables []string `arg:"--ignore-tables,help:table patterns to exclude from the generated Go code types [ie: 'tmp_*']"`
+
+	// tableFilter, ignoreTableFilter and ignoreFieldFilter are the compiled
+	// Tables, IgnoreTables and IgnoreFields patterns.
+	tableFilter       Filter `arg:"-"`
+	ignoreTableFilter Filter `arg:"-"`
+	ignoreFieldFilter Filter `arg:"-"`
 
 	// ForeignKeyMode is the foreign key mode for generating foreign key names.
 	ForeignKeyMode *FkMode `arg:"--fk-mode,-k,help:sets mode for naming foreign key funcs in generated Go code [values: <smart|parent|field|key>]"`
 
+	// ManyToManyMode is the mode for detecting the join tables of the
+	// generated many-to-many funcs.
+	ManyToManyMode *ManyToManyMode `arg:"--many-to-many,help:sets which join tables generate many-to-many funcs in generated Go code [values: <pure|all|none>]"`
+
 	// UseIndexNames toggles using index names.
 	//
 	// This is not enabled by default, because index names are often generated
@@ -77,6 +113,10 @@ type ArgType struct {
 	// cli args take precedence over stdin.
 	Query string `arg:"-Q,help:query to generate Go type and func from"`
 
+	// QueryDir is the directory of annotated .sql files to generate Go types
+	// and funcs from.
+	QueryDir string `arg:"--query-dir,help:directory of annotated .sql files to generate Go types and funcs from"`
+
 	// QueryType is the name to give to the Go type generated from the query.
 	QueryType string `arg:"--query-type,-T,help:query's generated Go type"`
 
@@ -132,6 +172,35 @@ type ArgType struct {
 	// so that users can query foreign key tables using the sqlx library
 	Sqlx bool `arg:"--sqlx,help:adds foreign key relationship structs and query functions to generated types to use with sqlx library"`
 
+	// Context toggles generating funcs that take a context.Context as their
+	// first parameter, and use the context aware methods of XODB (ie,
+	// ExecContext).
+	Context bool `arg:"--context,help:generate funcs taking a context.Context and using the context aware database methods"`
+
+	// PartialUpdates toggles tracking the fields changed since the rows were
+	// loaded, inserted or updated, so that Update only sets the changed
+	// columns.
+	PartialUpdates bool `arg:"--partial-updates,help:generate an Update only setting the columns changed since the row was loaded"`
+
+	// SoftDeleteColumn is the name of the column marking the soft deleted rows
+	// of the tables having it (ie, deleted_at or is_deleted). The Delete of
+	// these tables sets the column, and their rows are retrieved only when not
+	// soft deleted, unless by the IncludingDeleted funcs.
+	SoftDeleteColumn string `arg:"--soft-delete-column,help:name of the timestamp or boolean column marking the soft deleted rows"`
+
+	// CreatedColumns are the names of the timestamp columns set to the
+	// current time (ie, XONow) by the generated Insert and Upsert.
+	CreatedColumns []string `arg:"--created-columns,help:names of the timestamp columns set when inserting rows [ie: 'created_at' 'created_on']"`
+
+	// UpdatedColumns are the names of the timestamp columns set to the
+	// current time by the generated Insert, Update and Upsert.
+	UpdatedColumns []string `arg:"--updated-columns,help:names of the timestamp columns set when inserting or updating rows [ie: 'updated_at' 'modified_on']"`
+
+	// VersionColumn is the name of the integer column of the row versions
+	// checked and incremented by the generated Update and Delete (ie,
+	// optimistic locking).
+	VersionColumn string `arg:"--version-column,help:name of the integer column of the row versions used for optimistic locking"`
+
 	PgtypeMode *postgrestypes.PgtypeMode `arg:"--pg-type,help:Use types from the pgtype module. This gives better compatibility for the pgx driver for postgres. [values: <std|pgtype-full|pointer|pgtype>]"`
 
 	// NameConflictSuffix is the suffix used when a name conflicts with a scoped Go variable.
@@ -141,14 +210,36 @@ type ArgType struct {
 	// the built in versions.
 	TemplatePath string `arg:"--template-path,help:user supplied template path"`
 
+	// PartialsPath is the path to the shared template partials, whose block
+	// definitions are available to, and replace the blocks of, every template.
+	PartialsPath string `arg:"--partials-path,help:shared template partials path"`
+
 	// Tags is the list of build tags to add to generated Go files.
 	Tags string `arg:"--tags,help:build tags to add to package header"`
 
 	// TypeOverrides overrides types generated by gendal. It is not accessible
 	// from the command line because `go-arg` does not support maps.
 	// More information is available in the README.
-	TypeOverrides         []TypeOverride          `arg:"-"`
-	internalTypeOverrides map[string]TypeOverride `arg:"-"`
+	TypeOverrides               []TypeOverride          `arg:"-"`
+	internalTypeOverrides       map[string]TypeOverride `arg:"-"`
+	internalColumnTypeOverrides map[string]TypeOverride `arg:"-"`
+
+	// TypeImports are the package names of the overridden types to their
+	// import paths.
+	TypeImports map[string]string `arg:"-"`
+
+	// StructTags are the struct tags added to the fields of the generated
+	// types, and StructTagOverrides override their values for columns. Like
+	// TypeOverrides, they are only accessible from the config file.
+	// More information is available in the README.
+	StructTags                 []StructTag                  `arg:"-"`
+	StructTagOverrides         []StructTagOverride          `arg:"-"`
+	internalStructTagOverrides map[string]StructTagOverride `arg:"-"`
+
+	// ExtraTemplates are the user supplied templates executed in addition to
+	// the built in templates. They are only accessible from the config file.
+	// More information is available in the README.
+	ExtraTemplates []ExtraTemplate `arg:"-"`
 
 	// Path is the output path, as derived from Out.
 	Path string `arg:"-"`
@@ -171,6 +262,10 @@ type ArgType struct {
 	// Generated is the generated templates after a run.
 	Generated []TBuf `arg:"-"`
 
+	// queryTypes are the first queries of the query types generated by a run,
+	// by type name.
+	queryTypes map[string]*Query `arg:"-"`
+
 	// KnownTypeMap is the collection of known Go types.
 	KnownTypeMap map[string]bool `arg:"-"`
 
@@ -190,6 +285,31 @@ type TypeOverride struct {
 	NilValue         string
 	NullableType     string
 	NullableNilValue string
+
+	// Column is the name of the column to override the type of, in the form
+	// of 'table.column' (or 'schema.table.column'), or 'QueryType.field' in
+	// query mode. It is used instead of DatabaseType.
+	Column string
+
+	// Import is the import path of the package of the Go type, when it is
+	// not a package that can be found automatically.
+	Import string
+}
+
+// types returns the precision, nil value and Go type of the override.
+func (to TypeOverride) types(nullable bool) (int, string, string) {
+	if nullable {
+		return -1, to.NullableNilValue, to.NullableType
+	}
+	return -1, to.NilValue, to.Type_
+}
+
+// name returns the database type or column name the override applies to.
+func (to TypeOverride) name() string {
+	if to.Column != "" {
+		return to.Column
+	}
+	return to.DatabaseType
 }
 
 func warnIfUndefined(databaseType string, field string, value string) {
@@ -199,31 +319,77 @@ func warnIfUndefined(databaseType string, field string, value string) {
 }
 
 func (to *TypeOverride) warnIfIncomplete() {
-	warnIfUndefined(to.DatabaseType, "Type_", to.Type_)
-	warnIfUndefined(to.DatabaseType, "NilValue", to.NilValue)
-	warnIfUndefined(to.DatabaseType, "NullableType", to.NullableType)
-	warnIfUndefined(to.DatabaseType, "NullableNilValue", to.NullableNilValue)
+	warnIfUndefined(to.name(), "Type_", to.Type_)
+	warnIfUndefined(to.name(), "NilValue", to.NilValue)
+	warnIfUndefined(to.name(), "NullableType", to.NullableType)
+	warnIfUndefined(to.name(), "NullableNilValue", to.NullableNilValue)
 }
 
 func (args *ArgType) PopulateInternalTypeOverrides() error {
 	args.internalTypeOverrides = map[string]TypeOverride{}
+	args.internalColumnTypeOverrides = map[string]TypeOverride{}
+	args.TypeImports = map[string]string{}
 	for _, typeOverride := range args.TypeOverrides {
-		databaseType := strings.ToLower(typeOverride.DatabaseType)
-		if _, ok := args.internalTypeOverrides[databaseType]; ok {
+		if typeOverride.DatabaseType != "" && typeOverride.Column != "" {
+			return fmt.Errorf(
+				"Type override for %s cannot have both a DatabaseType and a Column",
+				typeOverride.DatabaseType,
+			)
+		}
+
+		overrides := args.internalTypeOverrides
+		if typeOverride.Column != "" {
+			overrides = args.internalColumnTypeOverrides
+		}
+
+		name := strings.ToLower(typeOverride.name())
+		if _, ok := overrides[name]; ok {
 			return errors.New(fmt.Sprintf(
 				"Duplicate type override: %s (types are case insensitive)",
-				typeOverride.DatabaseType,
+				typeOverride.name(),
 			))
 		}
-		args.internalTypeOverrides[databaseType] = typeOverride
+		overrides[name] = typeOverride
 		typeOverride.warnIfIncomplete()
+
+		// register the package of the type, so that it is imported by the
+		// generated code
+		if typeOverride.Import != "" {
+			for _, typ := range []string{typeOverride.Type_, typeOverride.NullableType} {
+				if pkg := typePackage(typ); pkg != "" {
+					args.TypeImports[pkg] = typeOverride.Import
+				}
+			}
+		}
 	}
 	return nil
 }
 
+// ColumnTypeOverride returns the type override for the first of the column
+// names (ie, 'table.column') that has one.
+func (args *ArgType) ColumnTypeOverride(names ...string) (TypeOverride, bool) {
+	for _, n := range names {
+		if to, ok := args.internalColumnTypeOverrides[strings.ToLower(n)]; ok {
+			return to, true
+		}
+	}
+	return TypeOverride{}, false
+}
+
+// typePackage returns the package name qualifying the Go type (ie, 'types'
+// for '*types.EmailAddress'), or an empty string if it is not qualified.
+func typePackage(typ string) string {
+	typ = strings.TrimLeft(typ, "*[]")
+	if i := strings.Index(typ, "."); i != -1 {
+		return typ[:i]
+	}
+	return ""
+}
+
 // NewDefaultArgs returns the default arguments.
 func NewDefaultArgs(version string) *ArgType {
 	fkMode := FkModeSmart
+	m2mMode := ManyToManyModePure
 	pgtypeMode := postgrestypes.PgtypeModeStd
 
 	return &ArgType{
@@ -231,6 +397,7 @@ func NewDefaultArgs(version string) *ArgType {
 		Int32Type:           "int",
 		Uint32Type:          "uint",
 		ForeignKeyMode:      &fkMode,
+		ManyToManyMode:      &m2mMode,
 		QueryParamDelimiter: "%%",
 		NameConflictSuffix:  "Val",
 		PgtypeMode:          &pgtypeMode,
@@ -281,6 +448,126 @@ func NewDefaultArgs(version string) *ArgType {
 	}
 }
 
+// Prepare checks the arguments, and sets the values derived from them (ie,
+// the package name from the output path). It does not access the
+// filesystem, so Path, and Filename when writing to a single file, should be
+// set beforehand when Out is not the output directory.
+func (a *ArgType) Prepare() error {
+	var err error
+
+	// split schema lists, dropping duplicates
+	schemas := []string{}
+	seen := map[string]bool{}
+	for _, s := range a.Schemas {
+		for _, n := range strings.Split(s, ",") {
+			n = strings.TrimSpace(n)
+			if n != "" && !seen[n] {
+				schemas = append(schemas, n)
+				seen[n] = true
+			}
+		}
+	}
+	a.Schemas = schemas
+
+	// compile table and field patterns
+	err = a.CompileFilters()
+	if err != nil {
+		return err
+	}
+
+	// determine path
+	if a.Path == "" {
+		a.Path = a.Out
+	}
+	if a.Path == "" {
+		a.Path = "."
+	}
+
+	// determine package name
+	if a.Package == "" {
+		a.Package = path.Base(a.Path)
+	}
+
+	// determine filename if not previously set
+	if a.Filename == "" {
+		a.Filename = a.Package + a.Suffix
+	}
+
+	// query dir parsing
+	if a.QueryDir != "" {
+		if a.Query != "" {
+			return errors.New("a query cannot be supplied with a query directory")
+		}
+
+		a.QueryMode = true
+	}
+
+	// query mode parsing
+	if a.Query != "" {
+		a.QueryMode = true
+	}
+
+	// check that query type was specified
+	if a.QueryMode && a.QueryDir == "" && a.QueryType == "" {
+		return errors.New("query type must be supplied for query parsing mode")
+	}
+
+	// query trim
+	if a.QueryMode && a.QueryTrim {
+		a.Query = strings.TrimSpace(a.Query)
+	}
+
+	// escape all
+	if a.EscapeAll {
+		a.EscapeSchemaName = true
+		a.EscapeTableNames = true
+		a.EscapeColumnNames = true
+	}
+
+	err = a.PopulateInternalTypeOverrides()
+	if err != nil {
+		return err
+	}
+
+	err = a.PopulateStructTags()
+	if err != nil {
+		return err
+	}
+
+	err = a.PopulateExtraTemplates()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Clone returns a copy of the arguments with their own known type and short
+// name maps, and without any generated templates, so that generating code
+// with the copy does not affect other runs.
+func (a *ArgType) Clone() *ArgType {
+	c := *a
+
+	c.Schemas = append([]string{}, a.Schemas...)
+	c.ExtraTemplates = append([]ExtraTemplate{}, a.ExtraTemplates...)
+
+	c.KnownTypeMap = make(map[string]bool, len(a.KnownTypeMap))
+	for k, v := range a.KnownTypeMap {
+		c.KnownTypeMap[k] = v
+	}
+
+	c.ShortNameTypeMap = make(map[string]string, len(a.ShortNameTypeMap))
+	for k, v := range a.ShortNameTypeMap {
+		c.ShortNameTypeMap[k] = v
+	}
+
+	c.templateSet = nil
+	c.Generated = nil
+	c.queryTypes = nil
+
+	return &c
+}
+
 // Description provides the description for the command output.
 func (a *ArgType) Description() string {
 	return "gendal is a command line utility to generate Go code from a database schema.\n"
@@ -290,6 +577,3 @@ func (a *ArgType) Description() string {
 func (a *ArgType) Version() string {
 	return "gendal version " + a.VersionNumber + "\n"
 }
-
-// Args are the application arguments.
-var Args *ArgType
@@ -0,0 +1,135 @@
+package internal_test
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"testing"
+
+	arg "github.com/alexflint/go-arg"
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_ColumnTypeOverride(t *testing.T) {
+	s := &internal.Snapshot{
+		Version:    internal.SnapshotVersion,
+		LoaderType: "sqlite3",
+		Tables: []*internal.SnapshotTable{
+			{
+				Relkind: "table",
+				Table:   &models.Table{TableName: "users"},
+				Columns: []*models.Column{
+					{ColumnName: "user_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "email", DataType: "TEXT", NotNull: true},
+					{FieldOrdinal: 2, ColumnName: "backup_email", DataType: "TEXT"},
+					{FieldOrdinal: 3, ColumnName: "name", DataType: "TEXT", NotNull: true},
+				},
+			},
+		},
+	}
+
+	args := internal.NewDefaultArgs("")
+	args.LoaderType = s.LoaderType
+	args.Loader = internal.SchemaLoaders[s.LoaderType].(internal.TypeLoader).FromSnapshot(s)
+	args.TypeOverrides = []internal.TypeOverride{
+		{
+			Column:           "users.email",
+			Type_:            "types.EmailAddress",
+			NilValue:         `""`,
+			NullableType:     "*types.EmailAddress",
+			NullableNilValue: "nil",
+			Import:           "example.com/types",
+		},
+		{
+			Column:           "USERS.BACKUP_EMAIL",
+			Type_:            "types.EmailAddress",
+			NilValue:         `""`,
+			NullableType:     "*types.EmailAddress",
+			NullableNilValue: "nil",
+			Import:           "example.com/types",
+		},
+	}
+	err := args.PopulateInternalTypeOverrides()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args.TypeImports["types"] != "example.com/types" {
+		t.Errorf("expected types package to be imported, got: %q", args.TypeImports["types"])
+	}
+
+	err = args.Loader.LoadSchema(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var src string
+	for _, tb := range args.Generated {
+		if tb.TemplateType == internal.TypeTemplate {
+			src = tb.Buf.String()
+		}
+	}
+
+	for _, exp := range []string{
+		`Email\s+types\.EmailAddress\s`,
+		`BackupEmail\s+\*types\.EmailAddress\s`,
+		`Name\s+string\s`,
+	} {
+		if !regexp.MustCompile(exp).MatchString(src) {
+			t.Errorf("expected generated type to match %q, got:\n%s", exp, src)
+		}
+	}
+}
+
+func Test_TypeOverrideDuplicate(t *testing.T) {
+	args := internal.NewDefaultArgs("")
+	args.TypeOverrides = []internal.TypeOverride{
+		{Column: "users.email", Type_: "string"},
+		{Column: "Users.Email", Type_: "string"},
+	}
+	if err := args.PopulateInternalTypeOverrides(); err == nil {
+		t.Fatalf("expected error for duplicate column type override")
+	}
+}
+
+func Test_Schemas(t *testing.T) {
+	tests := []struct {
+		desc string
+		args []string
+		exp  []string
+		hdr  string
+	}{
+		{"single", []string{"-s", "billing"}, []string{"billing"}, "schema 'billing'"},
+		{"repeated", []string{"-s", "billing", "--schema", "catalog"}, []string{"billing", "catalog"}, "schemas 'billing', 'catalog'"},
+		{"list", []string{"-s", "billing,catalog", "-s", "billing"}, []string{"billing", "catalog"}, "schemas 'billing', 'catalog'"},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		p, err := arg.NewParser(arg.Config{}, args)
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+		err = p.Parse(append([]string{"file:test.db"}, tt.args...))
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+		err = args.Prepare()
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+		if !reflect.DeepEqual(args.Schemas, tt.exp) {
+			t.Fatalf("test #%d: %s\n\texp: %v\n\tgot: %v", i+1, tt.desc, tt.exp, args.Schemas)
+		}
+
+		args.Schema = args.Schemas[0]
+		var buf bytes.Buffer
+		err = args.TemplateSet().Execute(&buf, "xo_package.go.tpl", args)
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+		if got := buf.String(); !regexp.MustCompile(`contains the types for ` + tt.hdr + `\.\n`).MatchString(got) {
+			t.Errorf("test #%d: %s\n\texpected header to name %s, got:\n%s", i+1, tt.desc, tt.hdr, got)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_BatchLoaders(t *testing.T) {
+	tests := []struct {
+		desc       string
+		loaderType string
+		intType    string
+		exp        []string
+		notExp     []string
+	}{
+		{
+			desc:       "postgres",
+			loaderType: "postgres",
+			intType:    "integer",
+			exp: []string{
+				"func AuthorsByAuthorIDs(db XODB, authorIDs []int) (map[int]*Author, error) {",
+				"func BooksByAuthorIDs(db XODB, authorIDs []int) (map[int][]*Book, error) {",
+				"func BooksByTitles(db XODB, titles []string) (map[string]*Book, error) {",
+				"func BooksByIsbns(db XODB, isbns []string) (map[string][]*Book, error) {",
+				"func BookTagsByTagIDs(db XODB, tagIDs []int) (map[int][]*BookTag, error) {",
+				"`WHERE author_id = ANY($1)`",
+				"q, err := db.Query(sqlstr, pq.Array(authorIDs))",
+				"res[b.AuthorID] = append(res[b.AuthorID], &b)",
+				"res[b.Title] = &b",
+				"err = q.Err()",
+			},
+			notExp: []string{"func AuthorsByNames(", "func BookTagsByTagIDs(db XODB, tagIDs []int) (map[int]*BookTag"},
+		},
+		{
+			desc:       "sqlite3",
+			loaderType: "sqlite3",
+			intType:    "integer",
+			exp: []string{
+				"func BooksByAuthorIDs(db XODB, authorIDs []int) (map[int][]*Book, error) {",
+				"if len(batch) > 999 {",
+				"params[i] = \"?\"",
+				"`WHERE author_id IN (` + strings.Join(params, \", \") + `)`",
+			},
+		},
+		{
+			desc:       "mssql",
+			loaderType: "mssql",
+			intType:    "int",
+			exp: []string{
+				"func BooksByAuthorIDs(db XODB, authorIDs []int) (map[int][]*Book, error) {",
+				"if len(batch) > 1000 {",
+				"params[i] = fmt.Sprintf(\"$%d\", i+1)",
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		src, err := generate(args, tt.loaderType, []*internal.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "authors"},
+				Columns: []*models.Column{
+					{ColumnName: "author_id", DataType: tt.intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "name", DataType: "text", NotNull: true},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "books"},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: tt.intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "author_id", DataType: tt.intType, NotNull: true},
+					{FieldOrdinal: 2, ColumnName: "title", DataType: "text", NotNull: true},
+					{FieldOrdinal: 3, ColumnName: "isbn", DataType: "text", NotNull: true},
+				},
+				ForeignKeys: []*models.ForeignKey{
+					{ForeignKeyName: "books_author_id_fkey", ColumnName: "author_id", RefTableName: "authors", RefColumnName: "author_id"},
+				},
+				Indexes: []*internal.SnapshotIndex{
+					{
+						Index:   &models.Index{IndexName: "books_author_idx"},
+						Columns: []*models.IndexColumn{{ColumnName: "author_id"}},
+					},
+					{
+						Index:   &models.Index{IndexName: "books_title_idx", IsUnique: true},
+						Columns: []*models.IndexColumn{{ColumnName: "title"}},
+					},
+					{
+						Index:   &models.Index{IndexName: "books_isbn_idx", IsUnique: true, IsPartial: true},
+						Columns: []*models.IndexColumn{{ColumnName: "isbn"}},
+					},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "book_tags", ManualPk: true},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: tt.intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "tag_id", DataType: tt.intType, NotNull: true, IsPrimaryKey: true},
+				},
+				ForeignKeys: []*models.ForeignKey{
+					{ForeignKeyName: "book_tags_book_id_fkey", ColumnName: "book_id", RefTableName: "books", RefColumnName: "book_id"},
+					{ForeignKeyName: "book_tags_tag_id_fkey", ColumnName: "tag_id", RefTableName: "tags", RefColumnName: "tag_id"},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "tags"},
+				Columns: []*models.Column{
+					{ColumnName: "tag_id", DataType: tt.intType, NotNull: true, IsPrimaryKey: true},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, tt.notExp)
+	}
+}
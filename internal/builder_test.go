@@ -0,0 +1,127 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_Builder(t *testing.T) {
+	tests := []struct {
+		desc       string
+		loaderType string
+		intType    string
+		escape     bool
+		exp        []string
+	}{
+		{
+			desc:       "postgres",
+			loaderType: "postgres",
+			intType:    "integer",
+			exp: []string{
+				"func (c AuthorAgeColumn) Gt(v sql.NullInt64) XOCond {",
+				"func (c AuthorNameColumn) In(vs ...string) XOCond {",
+				"func (c AuthorNameColumn) Like(pattern string) XOCond {",
+				"Name: AuthorNameColumn{XOColumn{`name`}},",
+				"func Authors(db XODB) *AuthorQuery {",
+				"`FROM public.authors`",
+				"sqlstr += fmt.Sprintf(` LIMIT %d`, q.limit)",
+				"func (q *AuthorQuery) All() ([]*Author, error) {",
+				"_exists: true,",
+				"return fmt.Sprintf(\"$%d\", n)",
+			},
+		},
+		{
+			desc:       "postgres escaped",
+			loaderType: "postgres",
+			intType:    "integer",
+			escape:     true,
+			exp: []string{
+				"Name: AuthorNameColumn{XOColumn{`\"name\"`}},",
+				"`FROM \"public\".\"authors\"`",
+			},
+		},
+		{
+			desc:       "sqlite3",
+			loaderType: "sqlite3",
+			intType:    "integer",
+			exp: []string{
+				"func Authors(db XODB) *AuthorQuery {",
+				"sqlstr += fmt.Sprintf(` LIMIT -1 OFFSET %d`, q.offset)",
+				"return \"?\"",
+			},
+		},
+		{
+			desc:       "mssql",
+			loaderType: "mssql",
+			intType:    "int",
+			exp: []string{
+				"func Authors(db XODB) *AuthorQuery {",
+				"sqlstr += ` ORDER BY (SELECT NULL)`",
+				"sqlstr += fmt.Sprintf(` FETCH NEXT %d ROWS ONLY`, q.limit)",
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		args.EscapeSchemaName = tt.escape
+		args.EscapeTableNames = tt.escape
+		args.EscapeColumnNames = tt.escape
+		src, err := generate(args, tt.loaderType, []*internal.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "authors"},
+				Columns: []*models.Column{
+					{ColumnName: "author_id", DataType: tt.intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "name", DataType: "text", NotNull: true},
+					{FieldOrdinal: 2, ColumnName: "age", DataType: tt.intType},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, nil)
+	}
+}
+
+func Test_BuilderOmitted(t *testing.T) {
+	notExp := []string{"type XOCond struct", "func XOAnd(", "func xoParam(", "type XOOrder struct", "type XOColumn struct"}
+
+	// schema without tables
+	args := internal.NewDefaultArgs("")
+	src, err := generate(args, "postgres", nil)
+	if err != nil {
+		t.Fatalf("test #1: no tables\n\tunexpected error: %v", err)
+	}
+	checkGenerated(t, 0, "no tables", src, []string{"type XODB interface {"}, notExp)
+
+	// query mode
+	q, err := internal.ParseQueryFile("a.sql", []byte("-- name: AuthorByID :one\n"+
+		"-- type: Author\n"+
+		"-- fields: AuthorID int, Name string\n"+
+		"SELECT author_id, name FROM authors WHERE author_id = %%id int%%;\n"))
+	if err != nil {
+		t.Fatalf("test #2: query mode\n\tunexpected error: %v", err)
+	}
+	args = internal.NewDefaultArgs("")
+	args.LoaderType = "sqlite3"
+	args.Loader = internal.SchemaLoaders["sqlite3"]
+	args.SetQuery(q[0])
+	err = args.Loader.ParseQuery(args)
+	if err != nil {
+		t.Fatalf("test #2: query mode\n\tunexpected error: %v", err)
+	}
+	err = args.ExecuteTemplate(internal.XOTemplate, "xo_db", "", args)
+	if err != nil {
+		t.Fatalf("test #2: query mode\n\tunexpected error: %v", err)
+	}
+	src = ""
+	for _, tb := range args.Generated {
+		src += tb.Buf.String()
+	}
+	checkGenerated(t, 1, "query mode", src, []string{"type Author struct {", "type XODB interface {"}, notExp)
+}
@@ -0,0 +1,175 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change in a
+// unified diff.
+const diffContext = 3
+
+// diffOp is a single line level edit operation.
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// splitLines splits s into lines, keeping the trailing newline (if any) off
+// of each line.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	for i, l := range lines {
+		lines[i] = strings.TrimSuffix(l, "\n")
+	}
+
+	return lines
+}
+
+// diffLines computes the line edits needed to turn a into b, using the
+// longest common subsequence of the two.
+func diffLines(a, b []string) []diffOp {
+	// lcs[i][j] is the length of the lcs of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	// walk the table, emitting ops
+	ops := make([]diffOp, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+
+	return ops
+}
+
+// UnifiedDiff returns the unified diff of a and b, labeling the old and new
+// contents with fromName and toName. An empty string is returned when a and
+// b are identical.
+func UnifiedDiff(fromName, toName string, a, b []byte) string {
+	if string(a) == string(b) {
+		return ""
+	}
+
+	ops := diffLines(splitLines(string(a)), splitLines(string(b)))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+
+	// aLine and bLine are the 1-based line numbers at the start of ops[i]
+	aLine, bLine := 1, 1
+	for i := 0; i < len(ops); {
+		// skip to next change
+		if ops[i].kind == ' ' {
+			aLine++
+			bLine++
+			i++
+			continue
+		}
+
+		// back up to include leading context
+		start := i
+		for start > 0 && i-start < diffContext && ops[start-1].kind == ' ' {
+			start--
+		}
+		aStart, bStart := aLine-(i-start), bLine-(i-start)
+
+		// extend the hunk until there is a run of unchanged lines long
+		// enough to separate it from the next change
+		end, same := i, 0
+		for ; end < len(ops); end++ {
+			if ops[end].kind != ' ' {
+				same = 0
+				continue
+			}
+			same++
+			if same > 2*diffContext {
+				end++
+				break
+			}
+		}
+		if same > diffContext {
+			end -= same - diffContext
+		}
+
+		// count lines in the hunk
+		aCount, bCount := 0, 0
+		for _, op := range ops[start:end] {
+			if op.kind != '+' {
+				aCount++
+			}
+			if op.kind != '-' {
+				bCount++
+			}
+		}
+
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(aStart, aCount), hunkRange(bStart, bCount))
+		for _, op := range ops[start:end] {
+			sb.WriteByte(op.kind)
+			sb.WriteString(op.line)
+			sb.WriteByte('\n')
+		}
+
+		// advance past the hunk
+		for ; i < end; i++ {
+			if ops[i].kind != '+' {
+				aLine++
+			}
+			if ops[i].kind != '-' {
+				bLine++
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// hunkRange formats the start,count range of a unified diff hunk header.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		// an empty range refers to the line before the hunk
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
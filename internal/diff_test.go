@@ -0,0 +1,61 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+)
+
+func Test_UnifiedDiff(t *testing.T) {
+	tests := []struct {
+		desc string
+		a    string
+		b    string
+		exp  string
+	}{
+		{
+			desc: "identical contents produce no diff",
+			a:    "a\nb\nc\n",
+			b:    "a\nb\nc\n",
+			exp:  "",
+		},
+		{
+			desc: "changed line",
+			a:    "a\nb\nc\n",
+			b:    "a\nx\nc\n",
+			exp:  "--- old\n+++ new\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n",
+		},
+		{
+			desc: "new file",
+			a:    "",
+			b:    "a\nb\n",
+			exp:  "--- old\n+++ new\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			desc: "removed file",
+			a:    "a\n",
+			b:    "",
+			exp:  "--- old\n+++ new\n@@ -1 +0,0 @@\n-a\n",
+		},
+		{
+			desc: "context is limited to three lines",
+			a:    "1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			b:    "1\n2\n3\n4\n5\n6\n7\n8\nx\n9\n",
+			exp:  "--- old\n+++ new\n@@ -6,4 +6,5 @@\n 6\n 7\n 8\n+x\n 9\n",
+		},
+		{
+			desc: "distant changes are split into hunks",
+			a:    "a\n1\n2\n3\n4\n5\n6\n7\nb\n",
+			b:    "x\n1\n2\n3\n4\n5\n6\n7\ny\n",
+			exp: "--- old\n+++ new\n@@ -1,4 +1,4 @@\n-a\n+x\n 1\n 2\n 3\n" +
+				"@@ -6,4 +6,4 @@\n 5\n 6\n 7\n-b\n+y\n",
+		},
+	}
+
+	for i, tt := range tests {
+		d := internal.UnifiedDiff("old", "new", []byte(tt.a), []byte(tt.b))
+		if d != tt.exp {
+			t.Fatalf("test #%d: %s\n\texp: %q\n\tgot: %q", i+1, tt.desc, tt.exp, d)
+		}
+	}
+}
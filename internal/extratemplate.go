@@ -0,0 +1,147 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/kenshaw/snaker"
+)
+
+// ExtraTemplate is a user supplied template executed in addition to the built
+// in templates, generating a file for every type, enum or stored procedure,
+// or a single file for the package.
+type ExtraTemplate struct {
+	// Name is the name of the template (ie, 'repository' or 'fixture').
+	Name string
+
+	// Template is the name of the template file in the template path or
+	// partials path. It defaults to the name with a '.go.tpl' extension.
+	Template string
+
+	// For is what the template is executed for, and the context of the
+	// template. It is one of 'type' (the default), 'enum', 'proc' or
+	// 'package'.
+	For string
+
+	// Filename is the pattern of the output filename, relative to the output
+	// path, where '{name}' is replaced by the lowercased Go name of the type,
+	// enum or stored procedure, '{Name}' by the Go name and '{snake}' by the
+	// snake cased Go name. It defaults to '{name}_' followed by the template
+	// name and the output file suffix, or to the template name and the suffix
+	// for the package.
+	Filename string
+
+	// Package is the package name of the generated files. It defaults to the
+	// package name of the generated code.
+	Package string
+
+	// Imports are the import paths of the packages referenced by the
+	// template (ie, the import path of the generated models).
+	Imports []string
+}
+
+// extraTemplateTypes are the template types the extra templates can be
+// executed for.
+var extraTemplateTypes = map[string]TemplateType{
+	"type":    TypeTemplate,
+	"enum":    EnumTemplate,
+	"proc":    ProcTemplate,
+	"package": XOTemplate,
+}
+
+// PopulateExtraTemplates checks the extra templates, setting their defaults.
+// It must be called after PopulateInternalTypeOverrides, as it adds the
+// imports of the templates to TypeImports.
+func (a *ArgType) PopulateExtraTemplates() error {
+	if a.TypeImports == nil {
+		a.TypeImports = map[string]string{}
+	}
+
+	names := map[string]bool{}
+	for i := range a.ExtraTemplates {
+		et := &a.ExtraTemplates[i]
+
+		if et.Name == "" || strings.ContainsAny(et.Name, `/\`) {
+			return fmt.Errorf("invalid extra template name '%s'", et.Name)
+		}
+		if names[et.Name] {
+			return fmt.Errorf("duplicate extra template '%s'", et.Name)
+		}
+		names[et.Name] = true
+
+		et.For = strings.ToLower(et.For)
+		if et.For == "" {
+			et.For = "type"
+		}
+		if _, ok := extraTemplateTypes[et.For]; !ok {
+			return fmt.Errorf("invalid value '%s' for extra template '%s' [values: <type|enum|proc|package>]", et.For, et.Name)
+		}
+
+		if et.Template == "" {
+			et.Template = et.Name + ".go.tpl"
+		}
+
+		if et.Filename == "" {
+			et.Filename = "{name}_" + et.Name + a.Suffix
+			if et.For == "package" {
+				et.Filename = et.Name + a.Suffix
+			}
+		}
+		if path.IsAbs(et.Filename) || strings.HasPrefix(path.Clean(et.Filename), "..") {
+			return fmt.Errorf("filename of extra template '%s' must be within the output path", et.Name)
+		}
+
+		for _, importPath := range et.Imports {
+			a.TypeImports[path.Base(importPath)] = importPath
+		}
+	}
+
+	return nil
+}
+
+// executeExtraTemplates executes the extra templates for the template type
+// with obj as the context, after the built in template was executed.
+func (a *ArgType) executeExtraTemplates(tt TemplateType, obj interface{}) error {
+	for _, et := range a.ExtraTemplates {
+		if t, ok := extraTemplateTypes[et.For]; !ok || t != tt {
+			continue
+		}
+
+		// the name of the type, enum or proc, or of the template for the
+		// package
+		itemName := et.Name
+		switch v := obj.(type) {
+		case *Type:
+			itemName = v.Name
+		case *Enum:
+			itemName = v.Name
+		case *Proc:
+			itemName = v.Name
+		}
+
+		v := TBuf{
+			TemplateType: UserTemplate,
+			Name:         itemName,
+			Subname:      et.Name,
+			Template:     et.Template,
+			Filename: strings.NewReplacer(
+				"{name}", strings.ToLower(itemName),
+				"{Name}", itemName,
+				"{snake}", snaker.CamelToSnake(itemName),
+			).Replace(et.Filename),
+			Package: et.Package,
+			Buf:     new(bytes.Buffer),
+		}
+
+		err := a.TemplateSet().Execute(v.Buf, et.Template, obj)
+		if err != nil {
+			return fmt.Errorf("extra template '%s': %v", et.Name, err)
+		}
+
+		a.Generated = append(a.Generated, v)
+	}
+
+	return nil
+}
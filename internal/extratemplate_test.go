@@ -0,0 +1,50 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+)
+
+func Test_PopulateExtraTemplates(t *testing.T) {
+	args := internal.NewDefaultArgs("")
+	args.Suffix = ".xo.go"
+	args.ExtraTemplates = []internal.ExtraTemplate{
+		{Name: "repository"},
+		{Name: "registry", For: "Package"},
+	}
+	err := args.PopulateExtraTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, exp := range []internal.ExtraTemplate{
+		{Name: "repository", Template: "repository.go.tpl", For: "type", Filename: "{name}_repository.xo.go"},
+		{Name: "registry", Template: "registry.go.tpl", For: "package", Filename: "registry.xo.go"},
+	} {
+		et := args.ExtraTemplates[i]
+		if et.Template != exp.Template || et.For != exp.For || et.Filename != exp.Filename {
+			t.Errorf("test #%d: expected %+v, got: %+v", i+1, exp, et)
+		}
+	}
+}
+
+func Test_PopulateExtraTemplatesError(t *testing.T) {
+	tests := []struct {
+		desc string
+		tpls []internal.ExtraTemplate
+	}{
+		{"empty name", []internal.ExtraTemplate{{For: "type"}}},
+		{"duplicate name", []internal.ExtraTemplate{{Name: "repository"}, {Name: "repository"}}},
+		{"invalid for", []internal.ExtraTemplate{{Name: "repository", For: "index"}}},
+		{"filename outside of output path", []internal.ExtraTemplate{{Name: "repository", Filename: "../{name}.go"}}},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		args.ExtraTemplates = tt.tpls
+		if err := args.PopulateExtraTemplates(); err == nil {
+			t.Errorf("test #%d: %s\n\texpected error", i+1, tt.desc)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Filter is a compiled list of table or column name patterns.
+//
+// A pattern is either a glob, where '*' and '?' do not match a '.', or a
+// regular expression when wrapped in slashes (ie, '/^app_(users|roles)$/').
+// Patterns are matched case insensitively against the name, and against the
+// name qualified by its table and schema (ie, 'payload', 'audit_log.payload'
+// and 'public.audit_log.payload'), so that a pattern can be scoped to a table
+// or schema by qualifying it.
+type Filter []*regexp.Regexp
+
+// CompileFilter compiles the table or column name patterns into a Filter.
+func CompileFilter(patterns []string) (Filter, error) {
+	f := Filter{}
+	for _, p := range patterns {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+
+		expr := globToRegexp(p)
+		if len(p) > 2 && strings.HasPrefix(p, "/") && strings.HasSuffix(p, "/") {
+			expr = p[1 : len(p)-1]
+		}
+
+		re, err := regexp.Compile("(?i)" + expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern '%s': %v", p, err)
+		}
+
+		f = append(f, re)
+	}
+
+	return f, nil
+}
+
+// Match returns true when any of the patterns match the name, qualified by
+// any of the parents of the name (ie, the schema and table names of a
+// column).
+func (f Filter) Match(parents []string, name string) bool {
+	// drop empty parents (ie, the schema of a sqlite table)
+	nonEmpty := []string{}
+	for _, p := range parents {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	parents = nonEmpty
+
+	for i := len(parents); i >= 0; i-- {
+		qualified := strings.Join(append(append([]string{}, parents[i:]...), name), ".")
+		for _, re := range f {
+			if re.MatchString(qualified) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// globToRegexp converts the glob pattern to an anchored regular expression,
+// where '*' and '?' do not match the '.' separating qualified names.
+func globToRegexp(glob string) string {
+	var sb strings.Builder
+	sb.WriteString("^")
+
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			sb.WriteString(`[^.]*`)
+		case '?':
+			sb.WriteString(`[^.]`)
+		case '[':
+			end := strings.IndexByte(glob[i:], ']')
+			if end == -1 {
+				sb.WriteString(regexp.QuoteMeta(glob[i:]))
+				i = len(glob)
+				continue
+			}
+			class := glob[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + class + "]")
+			i += end
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	sb.WriteString("$")
+	return sb.String()
+}
+
+// CompileFilters compiles the table and column include and exclude patterns.
+func (a *ArgType) CompileFilters() error {
+	var err error
+
+	a.tableFilter, err = CompileFilter(a.Tables)
+	if err != nil {
+		return err
+	}
+	a.ignoreTableFilter, err = CompileFilter(a.IgnoreTables)
+	if err != nil {
+		return err
+	}
+	a.ignoreFieldFilter, err = CompileFilter(a.IgnoreFields)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IncludeTable returns true when the table in the schema is matched by the
+// table include patterns, if any, and is not matched by the ignore patterns.
+func (a *ArgType) IncludeTable(schema, table string) bool {
+	if len(a.tableFilter) != 0 && !a.tableFilter.Match([]string{schema}, table) {
+		return false
+	}
+
+	return !a.ignoreTableFilter.Match([]string{schema}, table)
+}
+
+// IncludeColumn returns true when the column of the table in the schema is
+// not matched by the field ignore patterns.
+func (a *ArgType) IncludeColumn(schema, table, column string) bool {
+	return !a.ignoreFieldFilter.Match([]string{schema, table}, column)
+}
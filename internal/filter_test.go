@@ -0,0 +1,140 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+)
+
+func Test_FilterMatch(t *testing.T) {
+	tests := []struct {
+		desc     string
+		patterns []string
+		parents  []string
+		name     string
+		exp      bool
+	}{
+		{
+			desc:     "exact name is case insensitive",
+			patterns: []string{"Books"},
+			parents:  []string{"public"},
+			name:     "books",
+			exp:      true,
+		},
+		{
+			desc:     "glob",
+			patterns: []string{"app_*"},
+			parents:  []string{"public"},
+			name:     "app_users",
+			exp:      true,
+		},
+		{
+			desc:     "glob does not match other names",
+			patterns: []string{"app_*"},
+			parents:  []string{"public"},
+			name:     "users",
+			exp:      false,
+		},
+		{
+			desc:     "glob scoped to a schema",
+			patterns: []string{"billing.*"},
+			parents:  []string{"billing"},
+			name:     "invoices",
+			exp:      true,
+		},
+		{
+			desc:     "glob scoped to another schema",
+			patterns: []string{"billing.*"},
+			parents:  []string{"catalog"},
+			name:     "products",
+			exp:      false,
+		},
+		{
+			desc:     "column scoped to a table",
+			patterns: []string{"audit_log.payload"},
+			parents:  []string{"public", "audit_log"},
+			name:     "payload",
+			exp:      true,
+		},
+		{
+			desc:     "column scoped to another table",
+			patterns: []string{"audit_log.payload"},
+			parents:  []string{"public", "events"},
+			name:     "payload",
+			exp:      false,
+		},
+		{
+			desc:     "glob does not match across qualified names",
+			patterns: []string{"tmp_*"},
+			parents:  []string{"public", "tmp_data"},
+			name:     "id",
+			exp:      false,
+		},
+		{
+			desc:     "empty schema",
+			patterns: []string{"audit_log.payload"},
+			parents:  []string{"", "audit_log"},
+			name:     "payload",
+			exp:      true,
+		},
+		{
+			desc:     "character class",
+			patterns: []string{"log_[!a]*"},
+			parents:  []string{"public"},
+			name:     "log_b",
+			exp:      true,
+		},
+		{
+			desc:     "regexp",
+			patterns: []string{"/^app_(users|roles)$/"},
+			parents:  []string{"public"},
+			name:     "APP_ROLES",
+			exp:      true,
+		},
+		{
+			desc:     "no patterns",
+			patterns: nil,
+			parents:  []string{"public"},
+			name:     "books",
+			exp:      false,
+		},
+	}
+
+	for i, tt := range tests {
+		f, err := internal.CompileFilter(tt.patterns)
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		if got := f.Match(tt.parents, tt.name); got != tt.exp {
+			t.Fatalf("test #%d: %s\n\texp: %t\n\tgot: %t", i+1, tt.desc, tt.exp, got)
+		}
+	}
+}
+
+func Test_CompileFilterError(t *testing.T) {
+	_, err := internal.CompileFilter([]string{"/(/"})
+	if err == nil {
+		t.Fatalf("expected error compiling invalid regexp")
+	}
+}
+
+func Test_IncludeTable(t *testing.T) {
+	args := internal.NewDefaultArgs("")
+	args.Tables = []string{"app_*"}
+	args.IgnoreTables = []string{"app_tmp_*"}
+	err := args.CompileFilters()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, exp := range map[string]bool{
+		"app_users":     true,
+		"app_tmp_users": false,
+		"users":         false,
+	} {
+		if got := args.IncludeTable("public", name); got != exp {
+			t.Errorf("%s: expected %t, got: %t", name, exp, got)
+		}
+	}
+}
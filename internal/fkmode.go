@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/gedex/inflector"
 	"github.com/kenshaw/snaker"
 )
 
@@ -21,14 +22,16 @@ const (
 	// "<type>.<ParentName>".
 	//
 	// For example, if you have an `authors` and `books` tables, then the
-	// foreign key func will be Book.Author.
+	// foreign key func will be Book.Author, and the reverse foreign key func
+	// will be Author.Books.
 	FkModeParent
 
 	// FkModeField causes a foreign key field to be named in the form of
 	// "<type>.<ParentName>By<Field>".
 	//
 	// For example, if you have an `authors` and `books` tables, then the
-	// foreign key func will be Book.AuthorByAuthorID.
+	// foreign key func will be Book.AuthorByAuthorID, and the reverse foreign
+	// key func will be Author.BooksByAuthorID.
 	FkModeField
 
 	// FkModeKey causes a foreign key field to be named in the form of
@@ -36,7 +39,8 @@ const (
 	//
 	// For example, if you have an `authors` and `books` tables with a foreign
 	// key name of 'fk_123', then the foreign key func will be
-	// Book.AuthorByFk123.
+	// Book.AuthorByFk123, and the reverse foreign key func will be
+	// Author.BooksByFk123.
 	FkModeKey
 )
 
@@ -98,7 +102,46 @@ func fkName(mode FkMode, fkMap map[string]*ForeignKey, fk *ForeignKey) string {
 	return fkName(FkModeParent, fkMap, fk)
 }
 
+// revFkName returns the name for the reverse direction of the foreign key,
+// which is the func of the referenced type retrieving the referencing types.
+func revFkName(mode FkMode, fkMap map[string]*ForeignKey, fk *ForeignKey) string {
+	name := inflector.Pluralize(fk.Type.Name)
+
+	switch mode {
+	case FkModeParent:
+		return name
+	case FkModeField:
+		return name + "By" + fk.Field.Name
+	case FkModeKey:
+		return name + "By" + snaker.SnakeToCamelIdentifier(fk.ForeignKey.ForeignKeyName)
+	}
+
+	// mode is FkModeSmart
+	// inspect all foreign keys and use FkModeField if conflict found
+	for _, f := range fkMap {
+		if fk != f && fk.Type.Name == f.Type.Name && fk.RefType.Name == f.RefType.Name {
+			return revFkName(FkModeField, fkMap, fk)
+		}
+	}
+
+	// a field of the referenced type with the same name is a conflict as well
+	for _, f := range fk.RefType.Fields {
+		if f.Name == name {
+			return revFkName(FkModeField, fkMap, fk)
+		}
+	}
+
+	// no conflict, so use FkModeParent
+	return revFkName(FkModeParent, fkMap, fk)
+}
+
 // ForeignKeyName returns the foreign key name for the passed type.
 func (a *ArgType) ForeignKeyName(fkMap map[string]*ForeignKey, fk *ForeignKey) string {
 	return fkName(*a.ForeignKeyMode, fkMap, fk)
 }
+
+// ReverseForeignKeyName returns the reverse foreign key name for the passed
+// type.
+func (a *ArgType) ReverseForeignKeyName(fkMap map[string]*ForeignKey, fk *ForeignKey) string {
+	return revFkName(*a.ForeignKeyMode, fkMap, fk)
+}
@@ -0,0 +1,103 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_ReverseForeignKeys(t *testing.T) {
+	tests := []struct {
+		desc     string
+		mode     internal.FkMode
+		coauthor bool
+		exp      []string
+	}{
+		{
+			desc: "smart",
+			mode: internal.FkModeSmart,
+			exp: []string{
+				"func (a *Author) Books(db XODB) ([]*Book, error) {",
+				"`WHERE author_id = ?`",
+				"XOLog(sqlstr, a.AuthorID)",
+				"func (e *Employee) Employees(db XODB) ([]*Employee, error) {",
+				"eVal := Employee{",
+				"res = append(res, &eVal)\n\t}\n\terr = q.Err()",
+			},
+		},
+		{
+			desc:     "smart with conflict",
+			mode:     internal.FkModeSmart,
+			coauthor: true,
+			exp: []string{
+				"func (a *Author) BooksByAuthorID(db XODB) ([]*Book, error) {",
+				"func (a *Author) BooksByCoauthorID(db XODB) ([]*Book, error) {",
+				"`WHERE coauthor_id = ?`",
+			},
+		},
+		{
+			desc: "parent",
+			mode: internal.FkModeParent,
+			exp:  []string{"func (a *Author) Books(db XODB) ([]*Book, error) {"},
+		},
+		{
+			desc: "field",
+			mode: internal.FkModeField,
+			exp:  []string{"func (a *Author) BooksByAuthorID(db XODB) ([]*Book, error) {"},
+		},
+		{
+			desc: "key",
+			mode: internal.FkModeKey,
+			exp:  []string{"func (a *Author) BooksByBooksAuthorIDFkey(db XODB) ([]*Book, error) {"},
+		},
+	}
+
+	for i, tt := range tests {
+		books := &internal.SnapshotTable{
+			Table: &models.Table{TableName: "books"},
+			Columns: []*models.Column{
+				{ColumnName: "book_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+				{FieldOrdinal: 1, ColumnName: "author_id", DataType: "INTEGER", NotNull: true},
+				{FieldOrdinal: 2, ColumnName: "coauthor_id", DataType: "INTEGER"},
+			},
+			ForeignKeys: []*models.ForeignKey{
+				{ForeignKeyName: "books_author_id_fkey", ColumnName: "author_id", RefTableName: "authors", RefColumnName: "author_id"},
+			},
+		}
+		if tt.coauthor {
+			books.ForeignKeys = append(books.ForeignKeys, &models.ForeignKey{
+				ForeignKeyName: "books_coauthor_id_fkey", ColumnName: "coauthor_id", RefTableName: "authors", RefColumnName: "author_id",
+			})
+		}
+
+		args := internal.NewDefaultArgs("")
+		args.ForeignKeyMode = &tt.mode
+		src, err := generate(args, "sqlite3", []*internal.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "authors"},
+				Columns: []*models.Column{
+					{ColumnName: "author_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "name", DataType: "TEXT", NotNull: true},
+				},
+			},
+			books,
+			{
+				Table: &models.Table{TableName: "employees"},
+				Columns: []*models.Column{
+					{ColumnName: "employee_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "manager_id", DataType: "INTEGER"},
+				},
+				ForeignKeys: []*models.ForeignKey{
+					{ForeignKeyName: "employees_manager_id_fkey", ColumnName: "manager_id", RefTableName: "employees", RefColumnName: "employee_id"},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, nil)
+	}
+}
@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"strconv"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/imports"
+)
+
+// KnownImports is the map of package names referenced by the generated code
+// to their import paths. Packages in the map are imported directly, instead
+// of being searched for in GOPATH or the module cache.
+var KnownImports = map[string]string{
+	"context": "context",
+	"csv":     "encoding/csv",
+	"driver":  "database/sql/driver",
+	"errors":  "errors",
+	"fmt":     "fmt",
+	"hstore":  "github.com/lib/pq/hstore",
+	"mysql":   "github.com/go-sql-driver/mysql",
+	"net":     "net",
+	"pgtype":  "github.com/jackc/pgtype",
+	"pq":      "github.com/lib/pq",
+	"reflect": "reflect",
+	"regexp":  "regexp",
+	"sql":     "database/sql",
+	"sqlx":    "github.com/jmoiron/sqlx",
+	"strconv": "strconv",
+	"strings": "strings",
+	"time":    "time",
+	"xoutil":  "github.com/xo/xoutil",
+}
+
+// FormatSource formats the generated Go source src, adding missing imports
+// and removing unused ones. filename is used for error positions.
+// typeImports are the package names of overridden types to their import
+// paths, which are imported in addition to KnownImports, and may be nil.
+//
+// The imports are resolved in-process from KnownImports and typeImports.
+// Only when src references a package found in neither, or imports one not
+// in them, are the imports fixed by goimports, which searches GOPATH and
+// the module cache.
+//
+// When src is not valid Go, the returned error is a scanner.ErrorList.
+func FormatSource(filename string, src []byte, typeImports map[string]string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	// package names of the known import paths
+	names := map[string]string{}
+	for name, importPath := range KnownImports {
+		names[importPath] = name
+	}
+	for name, importPath := range typeImports {
+		names[importPath] = name
+	}
+
+	// names of the imported packages, unknown imports are left to goimports
+	unresolved := false
+	imported := map[string]bool{}
+	for _, imp := range f.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		name, ok := names[importPath]
+		switch {
+		case imp.Name != nil:
+			name = imp.Name.Name
+		case !ok:
+			name, unresolved = path.Base(importPath), true
+		}
+		imported[name] = true
+	}
+
+	// add known imports for unresolved package references
+	used := map[string]bool{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		id, ok := sel.X.(*ast.Ident)
+		if !ok || id.Obj != nil {
+			return true
+		}
+		used[id.Name] = true
+		if imported[id.Name] {
+			return true
+		}
+		if importPath, ok := typeImports[id.Name]; ok {
+			astutil.AddImport(fset, f, importPath)
+		} else if importPath, ok := KnownImports[id.Name]; ok {
+			astutil.AddImport(fset, f, importPath)
+		} else {
+			unresolved = true
+		}
+		imported[id.Name] = true
+		return true
+	})
+
+	// remove unused known imports
+	unused := []string{}
+	for _, imp := range f.Imports {
+		importPath, _ := strconv.Unquote(imp.Path.Value)
+		name, ok := names[importPath]
+		if ok && imp.Name == nil && !used[name] {
+			unused = append(unused, importPath)
+		}
+	}
+	for _, importPath := range unused {
+		astutil.DeleteImport(fset, f, importPath)
+	}
+
+	var buf bytes.Buffer
+	err = format.Node(&buf, fset, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// group the imports and format, fixing the remaining imports
+	return imports.Process(filename, buf.Bytes(), &imports.Options{
+		Comments:   true,
+		TabIndent:  true,
+		TabWidth:   8,
+		FormatOnly: !unresolved,
+	})
+}
@@ -0,0 +1,61 @@
+package internal_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+)
+
+func Test_FormatSource(t *testing.T) {
+	tests := []struct {
+		desc        string
+		src         string
+		typeImports map[string]string
+		exp         []string
+		notExp      []string
+	}{
+		{
+			desc: "known imports added",
+			src:  "package models\nfunc f(db *sql.DB) error { return errors.New(strings.TrimSpace(\" \")) }\n",
+			exp:  []string{"import (\n\t\"database/sql\"\n\t\"errors\"\n\t\"strings\"\n)\n"},
+		},
+		{
+			desc:   "unused known imports removed",
+			src:    "package models\nimport (\n\"fmt\"\n\"time\"\n\"github.com/jackc/pgtype\"\n)\nvar t time.Time\n",
+			exp:    []string{"import (\n\t\"time\"\n)\n"},
+			notExp: []string{"fmt", "pgtype"},
+		},
+		{
+			desc:        "type imports added",
+			src:         "package models\nvar e types.Email\n",
+			typeImports: map[string]string{"types": "example.com/app/types"},
+			exp:         []string{"import \"example.com/app/types\"\n"},
+		},
+		{
+			desc: "named imports kept",
+			src:  "package models\nimport _ \"github.com/lib/pq\"\n",
+			exp:  []string{"import _ \"github.com/lib/pq\"\n"},
+		},
+	}
+
+	for i, tt := range tests {
+		// the file does not exist, as the known imports are not searched for
+		buf, err := internal.FormatSource("/nonexistent/models/f.xo.go", []byte(tt.src), tt.typeImports)
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		src := string(buf)
+		for _, e := range tt.exp {
+			if !strings.Contains(src, e) {
+				t.Errorf("test #%d: %s\n\texpected formatted source to contain %q, got:\n%s", i+1, tt.desc, e, src)
+			}
+		}
+		for _, e := range tt.notExp {
+			if strings.Contains(src, e) {
+				t.Errorf("test #%d: %s\n\texpected formatted source to not contain %q, got:\n%s", i+1, tt.desc, e, src)
+			}
+		}
+	}
+}
@@ -1,10 +1,12 @@
 package internal
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 	"text/template"
 
+	"github.com/gedex/inflector"
 	"github.com/kenshaw/snaker"
 
 	"github.com/turnkey-commerce/gendal/models"
@@ -18,6 +20,7 @@ func (a *ArgType) NewTemplateFuncs() template.FuncMap {
 		"colnamesmulti":      a.colnamesmulti,
 		"colnamesquery":      a.colnamesquery,
 		"colnamesquerymulti": a.colnamesquerymulti,
+		"colnamesfmt":        a.colnamesfmt,
 		"colprefixnames":     a.colprefixnames,
 		"colvals":            a.colvals,
 		"colvalsmulti":       a.colvalsmulti,
@@ -29,16 +32,323 @@ func (a *ArgType) NewTemplateFuncs() template.FuncMap {
 		"shortname":          a.shortname,
 		"convext":            a.convext,
 		"schema":             a.schemafn,
+		"schemadesc":         a.schemadesc,
 		"colname":            a.colname,
 		"hascolumn":          a.hascolumn,
 		"hasfield":           a.hasfield,
+		"hastypes":           a.hastypes,
 		"getstartcount":      a.getstartcount,
 		"foreignDBName":      a.foreignDBName,
 		"foreignFieldName":   a.foreignFieldName,
 		"convertName":        a.convertName,
+		"fieldtag":           a.fieldtag,
+		"ctxparam":           a.ctxparam,
+		"ctxarg":             a.ctxarg,
+		"ctxmethod":          a.ctxmethod,
+		"pluralize":          a.pluralize,
+		"batchrows":          a.batchrows,
+		"paramexpr":          a.paramexpr,
+		"nthparam":           a.nthparam,
+		"orderfields":        a.orderfields,
+		"partialupdates":     a.partialupdates,
+		"includingdeleted":   a.includingdeleted,
+		"softdeletecond":     a.softdeletecond,
+		"softdeletedcol":     a.softdeletedcol,
+		"softdeleteset":      a.softdeleteset,
+		"softdeletefield":    a.softdeletefield,
+		"softdeletefields":   a.softdeletefields,
+		"softdeleteargs":     a.softdeleteargs,
+		"softdeletecount":    a.softdeletecount,
+		"softdeletenow":      softdeletenow,
+		"timeval":            a.timeval,
+		"lockfields":         a.lockfields,
+		"versionset":         a.versionset,
+		"versionfields":      a.versionfields,
 	}
 }
 
+// ctxparam returns the context.Context parameter preceding the XODB parameter
+// of generated funcs when Context is toggled, and an empty string otherwise.
+func (a *ArgType) ctxparam() string {
+	if a.Context {
+		return "ctx context.Context, "
+	}
+	return ""
+}
+
+// ctxarg returns the context.Context argument preceding the other arguments
+// of calls to generated funcs and XODB methods when Context is toggled, and an
+// empty string otherwise.
+func (a *ArgType) ctxarg() string {
+	if a.Context {
+		return "ctx, "
+	}
+	return ""
+}
+
+// ctxmethod returns the name of the context aware variant of the XODB method
+// name when Context is toggled (ie, 'ExecContext' for 'Exec'), and name
+// otherwise.
+func (a *ArgType) ctxmethod(name string) string {
+	if a.Context {
+		return name + "Context"
+	}
+	return name
+}
+
+// pluralize returns the plural of the Go name (ie, 'Authors' for 'Author').
+func (a *ArgType) pluralize(name string) string {
+	return inflector.Pluralize(name)
+}
+
+// batchrows returns the maximum number of rows of the multi-row inserts of the
+// fields, excluding any Field with Name contained in ignoreNames.
+func (a *ArgType) batchrows(fields []*Field, ignoreNames ...string) int {
+	return a.Loader.BatchRows(a.colcount(fields, ignoreNames...) - 1)
+}
+
+// paramexpr returns the Go expression of the placeholder of the 1-based param
+// number n of a query, where n is a Go expression (ie, 'fmt.Sprintf("$%d", i)'
+// or '"?"').
+func (a *ArgType) paramexpr(n string) string {
+	mask := a.Loader.Mask()
+	if !strings.Contains(mask, "%d") {
+		return strconv.Quote(mask)
+	}
+
+	return fmt.Sprintf("fmt.Sprintf(%q, %s)", mask, n)
+}
+
+// nthparam returns the placeholder of the 0-based param i of a query.
+func (a *ArgType) nthparam(i int) string {
+	return a.Loader.NthParam(i)
+}
+
+// partialupdates returns whether the changed fields of the rows of the type
+// are tracked, which is when PartialUpdates is toggled and the type has a
+// primary key.
+func (a *ArgType) partialupdates(t *Type) bool {
+	return a.PartialUpdates && t.PrimaryKey != nil
+}
+
+// includingdeleted returns whether each of the variants of the funcs
+// retrieving the rows of the type includes the soft deleted rows, which is
+// false for the only variant when the type has no soft delete column.
+func (a *ArgType) includingdeleted(t *Type) []bool {
+	if t.SoftDelete == nil {
+		return []bool{false}
+	}
+
+	return []bool{false, true}
+}
+
+// softdeletebool returns whether the soft delete column of the type is a
+// boolean, and not a timestamp.
+func softdeletebool(t *Type) bool {
+	return strings.Contains(strings.ToLower(t.SoftDelete.Type), "bool")
+}
+
+// softdeletenow returns whether the soft delete column of the type is set to
+// the current time provided by XONow, as a param, which is when soft deleting
+// the rows of a type with a time soft delete field.
+func softdeletenow(t *Type, deleted bool) bool {
+	return deleted && !softdeletebool(t) && timevalue(t.SoftDelete.Type, "now") != ""
+}
+
+// softdeleteval returns the SQL value of the soft delete column of the type
+// for the soft deleted rows, or for the other rows.
+func (a *ArgType) softdeleteval(t *Type, deleted bool) string {
+	switch {
+	case softdeletenow(t, deleted):
+		return a.Loader.NthParam(0)
+	case !softdeletebool(t) && deleted:
+		return "CURRENT_TIMESTAMP"
+	case !softdeletebool(t):
+		return "NULL"
+	case a.LoaderType == "postgres":
+		return strconv.FormatBool(deleted)
+	case deleted:
+		return "1"
+	}
+
+	return "0"
+}
+
+// softdeletecond returns the SQL condition of the rows of the type not being
+// soft deleted, with the column prefixed by prefix (ie, 't.deleted_at IS
+// NULL').
+func (a *ArgType) softdeletecond(t *Type, prefix string) string {
+	col := prefix + a.colname(t.SoftDelete.Col)
+	if !softdeletebool(t) {
+		return col + " IS NULL"
+	}
+
+	cond := col + " = " + a.softdeleteval(t, false)
+	if !t.SoftDelete.Col.NotNull {
+		cond = "(" + col + " IS NULL OR " + cond + ")"
+	}
+
+	return cond
+}
+
+// softdeletedcol returns the SQL expression selecting whether the rows of the
+// type are soft deleted, as 0 or 1.
+func (a *ArgType) softdeletedcol(t *Type) string {
+	return "CASE WHEN " + a.softdeletecond(t, "") + " THEN 0 ELSE 1 END"
+}
+
+// softdeleteset returns the SQL assignments of the soft delete column of the
+// type, marking the rows as soft deleted or restoring them, and of its
+// updated timestamp columns, numbering the params from the first one.
+func (a *ArgType) softdeleteset(t *Type, deleted bool) string {
+	sets := []string{a.colname(t.SoftDelete.Col) + " = " + a.softdeleteval(t, deleted)}
+	n := a.softdeletecount(t, deleted) - len(a.softdeletefields(t))
+	for i, f := range a.softdeletefields(t) {
+		sets = append(sets, a.colname(f.Col)+" = "+a.Loader.NthParam(n+i))
+	}
+
+	return strings.Join(sets, ", ")
+}
+
+// softdeletefields returns the updated timestamp fields of the type set when
+// soft deleting or restoring its rows.
+func (a *ArgType) softdeletefields(t *Type) []*Field {
+	var fields []*Field
+	for _, f := range t.UpdatedFields {
+		if f != t.SoftDelete {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// softdeleteargs returns the Go args of the params of softdeleteset, with the
+// fields of the type prefixed by prefix (ie, 'pq.NullTime{Time: now, Valid:
+// true}, b.UpdatedAt').
+func (a *ArgType) softdeleteargs(t *Type, deleted bool, prefix string) string {
+	var args []string
+	if softdeletenow(t, deleted) {
+		args = append(args, timevalue(t.SoftDelete.Type, "now"))
+	}
+	for _, f := range a.softdeletefields(t) {
+		args = append(args, prefix+"."+f.Name)
+	}
+
+	return strings.Join(args, ", ")
+}
+
+// softdeletecount returns the number of params of softdeleteset.
+func (a *ArgType) softdeletecount(t *Type, deleted bool) int {
+	n := len(a.softdeletefields(t))
+	if softdeletenow(t, deleted) {
+		n++
+	}
+
+	return n
+}
+
+// softdeletefield returns the Go value of the soft delete field of the type
+// once the row is soft deleted or restored, or an empty string when the
+// field is left as is (ie, for the CURRENT_TIMESTAMP of a soft deleted row
+// with a field that is not a time).
+func (a *ArgType) softdeletefield(t *Type, deleted bool) string {
+	switch {
+	case softdeletenow(t, deleted):
+		return timevalue(t.SoftDelete.Type, "now")
+	case !softdeletebool(t) && deleted:
+		return ""
+	case !softdeletebool(t):
+		return t.SoftDelete.NilType
+	case t.SoftDelete.Type == "bool":
+		return strconv.FormatBool(deleted)
+	case t.SoftDelete.Type == "sql.NullBool":
+		return fmt.Sprintf("sql.NullBool{Bool: %t, Valid: true}", deleted)
+	}
+
+	return ""
+}
+
+// timevalue returns the Go value of the type typ for the time.Time expression
+// t (ie, 'pq.NullTime{Time: now, Valid: true}'), or an empty string when typ is
+// not a time type.
+func timevalue(typ string, t string) string {
+	switch typ {
+	case "time.Time":
+		return t
+	case "*time.Time":
+		return "&" + t
+	case "pq.NullTime", "mysql.NullTime", "sql.NullTime":
+		return typ + "{Time: " + t + ", Valid: true}"
+	case "xoutil.SqTime":
+		return typ + "{Time: " + t + "}"
+	case "pgtype.Timestamptz", "pgtype.Timestamp", "pgtype.Date":
+		return typ + "{Time: " + t + ", Status: pgtype.Present}"
+	}
+
+	return ""
+}
+
+// timeval returns the Go value of the timestamp field for the time.Time
+// expression t.
+func (a *ArgType) timeval(f *Field, t string) string {
+	return timevalue(f.Type, t)
+}
+
+// lockfields returns the fields identifying the row of the type being updated
+// or deleted, which are its primary key fields followed by its version field,
+// if any.
+func (a *ArgType) lockfields(t *Type) []*Field {
+	if t.Version == nil {
+		return t.PrimaryKeyFields
+	}
+
+	fields := make([]*Field, 0, len(t.PrimaryKeyFields)+1)
+	fields = append(fields, t.PrimaryKeyFields...)
+
+	return append(fields, t.Version)
+}
+
+// versionset returns the assignment incrementing the version column of the
+// type (ie, 'lock_version = lock_version + 1'), with the current value of the
+// column qualified by prefix when provided (ie, 't.lock_version + 1').
+func (a *ArgType) versionset(t *Type, prefix ...string) string {
+	col := a.colname(t.Version.Col)
+	if len(prefix) != 0 {
+		return col + " = " + prefix[0] + "." + col + " + 1"
+	}
+	return col + " = " + col + " + 1"
+}
+
+// versionfields returns the version field of the type as a slice, or nil when
+// it has none, for excluding it from the fields set by the upserts.
+func (a *ArgType) versionfields(t *Type) []*Field {
+	if t.Version == nil {
+		return nil
+	}
+	return []*Field{t.Version}
+}
+
+// orderfields returns the fields of the orders of the type, in the order of
+// the fields of the type, which are the fields of the cursor of its list func.
+func (a *ArgType) orderfields(t *Type) []*Field {
+	used := map[*Field]bool{}
+	for _, o := range t.Orders {
+		for _, f := range o.Fields {
+			used[f] = true
+		}
+	}
+
+	var fields []*Field
+	for _, f := range t.Fields {
+		if used[f] {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
 func (a *ArgType) foreignFieldName(col string) string {
 	return (col[:len(col)-2])
 }
@@ -306,6 +616,36 @@ func (a *ArgType) colnamesquerymulti(fields []*Field, sep string, startCount int
 	return str
 }
 
+// colnamesfmt creates a list of the column names found in fields formatted
+// using format, joined with sep, and excluding any Field contained in any of
+// ignoreFields.
+//
+// The format is a fmt format using explicit argument indexes, with the column
+// name as the first argument and the value place holder as the second (ie, "%[1]s = EXCLUDED.%[1]s" or
+// "%[2]s AS %[1]s"). Place holders are numbered over the included fields only.
+//
+// Used to present column assignments or conditions in an upsert (ie,
+// "field_1 = EXCLUDED.field_1, field_2 = EXCLUDED.field_2, ...").
+func (a *ArgType) colnamesfmt(fields []*Field, format string, sep string, ignoreFields ...[]*Field) string {
+	ignore := map[string]bool{}
+	for _, fs := range ignoreFields {
+		for _, f := range fs {
+			ignore[f.Name] = true
+		}
+	}
+
+	strs := []string{}
+	for _, f := range fields {
+		if ignore[f.Name] {
+			continue
+		}
+
+		strs = append(strs, fmt.Sprintf(format, a.colname(f.Col), a.Loader.NthParam(len(strs))))
+	}
+
+	return strings.Join(strs, sep)
+}
+
 // colprefixnames creates a list of the column names found in fields with the
 // supplied prefix, excluding any Field with Name contained in ignoreNames.
 //
@@ -468,6 +808,20 @@ func (a *ArgType) colcount(fields []*Field, ignoreNames ...string) int {
 	return i
 }
 
+// paramName returns the name of a Go func param for the Go name (ie,
+// 'authorID' for 'AuthorID'), which is not a Go reserved name.
+func paramName(name string) string {
+	n := strings.Split(snaker.CamelToSnake(name), "_")
+	s := strings.ToLower(n[0]) + name[len(n[0]):]
+
+	// check go reserved names
+	if r, ok := goReservedNames[strings.ToLower(s)]; ok {
+		s = r
+	}
+
+	return s
+}
+
 // goReservedNames is a map of of go reserved names to "safe" names.
 var goReservedNames = map[string]string{
 	"break":       "brk",
@@ -546,13 +900,7 @@ func (a *ArgType) goparamlist(fields []*Field, addPrefix bool, addType bool, ign
 
 		s := "v" + strconv.Itoa(i)
 		if len(f.Name) > 0 {
-			n := strings.Split(snaker.CamelToSnake(f.Name), "_")
-			s = strings.ToLower(n[0]) + f.Name[len(n[0]):]
-		}
-
-		// check go reserved names
-		if r, ok := goReservedNames[strings.ToLower(s)]; ok {
-			s = r
+			s = paramName(f.Name)
 		}
 
 		// add the go type
@@ -623,6 +971,21 @@ func (a *ArgType) schemafn(s string, names ...string) string {
 	return s + n
 }
 
+// schemadesc returns the description of the schemas of the generated code
+// used in comments (ie, "schema 'public'" or "schemas 'billing', 'catalog'").
+func (a *ArgType) schemadesc() string {
+	if len(a.Schemas) < 2 {
+		return "schema '" + a.schemafn(a.Schema) + "'"
+	}
+
+	names := make([]string, len(a.Schemas))
+	for i, s := range a.Schemas {
+		names[i] = "'" + a.schemafn(s) + "'"
+	}
+
+	return "schemas " + strings.Join(names, ", ")
+}
+
 // colname returns the ColumnName of col, optionally escaping it if
 // ArgType.EscapeColumnNames is toggled.
 func (a *ArgType) colname(col *models.Column) string {
@@ -657,6 +1020,18 @@ func (a *ArgType) hasfield(fields []*Field, name string) bool {
 	return false
 }
 
+// hastypes determines if the types of any tables or views were generated,
+// which use the query builder.
+func (a *ArgType) hastypes() bool {
+	for _, tb := range a.Generated {
+		if tb.TemplateType == TypeTemplate {
+			return true
+		}
+	}
+
+	return false
+}
+
 // getstartcount returns a starting count for numbering columsn in queries
 func (a *ArgType) getstartcount(fields []*Field, pkFields []*Field) int {
 	return len(fields) - len(pkFields)
@@ -0,0 +1,66 @@
+package internal_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+)
+
+// generate loads the tables, as tables of the public schema of a snapshot of
+// the loader type, into args, returning the code generated for them and for
+// xo_db. The tables default to the table relkind of the loader.
+func generate(args *internal.ArgType, loaderType string, tables []*internal.SnapshotTable) (string, error) {
+	tl := internal.SchemaLoaders[loaderType].(internal.TypeLoader)
+
+	s := &internal.Snapshot{
+		Version:    internal.SnapshotVersion,
+		LoaderType: loaderType,
+		Schemas:    []string{"public"},
+	}
+	for _, t := range tables {
+		st := *t
+		st.Schema = "public"
+		if st.Relkind == "" {
+			st.Relkind = tl.Relkind(internal.Table)
+		}
+		s.Tables = append(s.Tables, &st)
+	}
+
+	args.LoaderType = loaderType
+	args.Schemas = s.Schemas
+	args.Loader = tl.FromSnapshot(s)
+
+	err := args.Loader.LoadSchema(args)
+	if err != nil {
+		return "", err
+	}
+	err = args.ExecuteTemplate(internal.XOTemplate, "xo_db", "", args)
+	if err != nil {
+		return "", err
+	}
+
+	var src string
+	for _, tb := range args.Generated {
+		src += tb.Buf.String()
+	}
+
+	return src, nil
+}
+
+// checkGenerated checks that the generated code src of test i contains all of
+// exp and none of notExp.
+func checkGenerated(t *testing.T, i int, desc string, src string, exp, notExp []string) {
+	t.Helper()
+
+	for _, e := range exp {
+		if !strings.Contains(src, e) {
+			t.Errorf("test #%d: %s\n\texpected generated code to contain %q, got:\n%s", i+1, desc, e, src)
+		}
+	}
+	for _, e := range notExp {
+		if strings.Contains(src, e) {
+			t.Errorf("test #%d: %s\n\texpected generated code to not contain %q, got:\n%s", i+1, desc, e, src)
+		}
+	}
+}
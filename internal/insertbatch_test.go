@@ -0,0 +1,103 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_BatchRows(t *testing.T) {
+	tests := []struct {
+		loader internal.TypeLoader
+		cols   int
+		exp    int
+	}{
+		{internal.TypeLoader{}, 2, 32767},
+		{internal.TypeLoader{}, 0, 65535},
+		{internal.TypeLoader{ParamLimit: 999}, 3, 333},
+		{internal.TypeLoader{ParamLimit: 999}, 1000, 1},
+		{internal.TypeLoader{ParamLimit: 2098, RowLimit: 1000}, 1, 1000},
+		{internal.TypeLoader{ParamLimit: 2098, RowLimit: 1000}, 4, 524},
+	}
+
+	for i, tt := range tests {
+		n := tt.loader.BatchRows(tt.cols)
+		if n != tt.exp {
+			t.Errorf("test #%d: %d columns\n\texpected %d rows, got: %d", i+1, tt.cols, tt.exp, n)
+		}
+	}
+}
+
+func Test_InsertBatch(t *testing.T) {
+	tests := []struct {
+		desc       string
+		loaderType string
+		exp        []string
+	}{
+		{
+			desc:       "postgres",
+			loaderType: "postgres",
+			exp: []string{
+				"func InsertBooks(db XODB, bs []*Book) error {",
+				"if len(batch) > 32767 {",
+				`params = append(params, fmt.Sprintf("$%d", j+1))`,
+				"` RETURNING book_id`",
+				"err = q.Scan(&batch[i].BookID)",
+			},
+		},
+		{
+			desc:       "mysql",
+			loaderType: "mysql",
+			exp: []string{
+				"func InsertBooks(db XODB, bs []*Book) error {",
+				"if len(batch) > 32767 {",
+				`params = append(params, "?")`,
+				"// The primary keys provided by autoincrement are not retrieved, so the inserted",
+			},
+		},
+		{
+			desc:       "sqlite3",
+			loaderType: "sqlite3",
+			exp: []string{
+				"func InsertBooks(db XODB, bs []*Book) error {",
+				"if len(batch) > 499 {",
+				"id, err := res.LastInsertId()",
+				"b.BookID = int(id - int64(len(batch)-1-i))",
+			},
+		},
+		{
+			desc:       "mssql",
+			loaderType: "mssql",
+			exp: []string{
+				"func InsertBooks(db XODB, bs []*Book) error {",
+				"if len(batch) > 1000 {",
+				"vals[i] = `(` + strings.Join(params, \", \") + `, ` + strconv.Itoa(i) + `)`",
+				"`USING (VALUES ` + strings.Join(vals, \", \") + `) AS s (author_id, title, xo_index) ` +",
+				"`OUTPUT s.xo_index, inserted.book_id;`",
+				"batch[i].BookID = id",
+				"batch[i]._exists = true",
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		src, err := generate(args, tt.loaderType, []*internal.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "books"},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: "integer", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "author_id", DataType: "integer", NotNull: true},
+					{FieldOrdinal: 2, ColumnName: "title", DataType: "text", NotNull: true},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, nil)
+	}
+}
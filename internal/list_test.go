@@ -0,0 +1,99 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_List(t *testing.T) {
+	tests := []struct {
+		desc       string
+		loaderType string
+		intType    string
+		exp        []string
+		notExp     []string
+	}{
+		{
+			desc:       "postgres",
+			loaderType: "postgres",
+			intType:    "integer",
+			exp: []string{
+				"ListBooksByBookID ListBooksOrder = iota",
+				"// ListBooksByTitle orders the Books by unique index 'books_title_idx'.",
+				"func ListBooks(db XODB, opts ListBooksOpts) ([]*Book, *ListBooksCursor, error) {",
+				"after = []interface{}{opts.After.Title}",
+				"conds = append(conds, cols[j]+op+fmt.Sprintf(\"$%d\", len(args)))",
+				"sqlstr += fmt.Sprintf(` LIMIT %d`, opts.Limit)",
+				"sqlstr += fmt.Sprintf(` OFFSET %d`, opts.Offset)",
+				"cols = []string{`book_id`, `tag_id`}",
+				"after = []interface{}{opts.After.BookID, opts.After.TagID}",
+				"return res, &ListBooksCursor{",
+			},
+			notExp: []string{"ListBooksByIsbn", "ListBooksBySubtitle"},
+		},
+		{
+			desc:       "sqlite3",
+			loaderType: "sqlite3",
+			intType:    "integer",
+			exp: []string{
+				"func ListBooks(db XODB, opts ListBooksOpts) ([]*Book, *ListBooksCursor, error) {",
+				"conds = append(conds, cols[j]+op+\"?\")",
+				"sqlstr += fmt.Sprintf(` LIMIT -1 OFFSET %d`, opts.Offset)",
+			},
+		},
+		{
+			desc:       "mssql",
+			loaderType: "mssql",
+			intType:    "int",
+			exp: []string{
+				"func ListBooks(db XODB, opts ListBooksOpts) ([]*Book, *ListBooksCursor, error) {",
+				"sqlstr += fmt.Sprintf(` OFFSET %d ROWS`, opts.Offset)",
+				"sqlstr += fmt.Sprintf(` FETCH NEXT %d ROWS ONLY`, opts.Limit)",
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		src, err := generate(args, tt.loaderType, []*internal.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "books"},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: tt.intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "title", DataType: "text", NotNull: true},
+					{FieldOrdinal: 2, ColumnName: "subtitle", DataType: "text"},
+					{FieldOrdinal: 3, ColumnName: "isbn", DataType: "text", NotNull: true},
+				},
+				Indexes: []*internal.SnapshotIndex{
+					{
+						Index:   &models.Index{IndexName: "books_title_idx", IsUnique: true},
+						Columns: []*models.IndexColumn{{ColumnName: "title"}},
+					},
+					{
+						Index:   &models.Index{IndexName: "books_subtitle_idx", IsUnique: true},
+						Columns: []*models.IndexColumn{{ColumnName: "subtitle"}},
+					},
+					{
+						Index:   &models.Index{IndexName: "books_isbn_idx", IsUnique: true, IsPartial: true},
+						Columns: []*models.IndexColumn{{ColumnName: "isbn"}},
+					},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "book_tags", ManualPk: true},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: tt.intType, NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "tag_id", DataType: tt.intType, NotNull: true, IsPrimaryKey: true},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, tt.notExp)
+	}
+}
@@ -3,8 +3,7 @@ package internal
 import (
 	"errors"
 	"fmt"
-	"math/rand"
-	"strconv"
+	"sort"
 	"strings"
 
 	"github.com/xo/dburl"
@@ -24,6 +23,11 @@ type Loader interface {
 	// Mask returns the mask.
 	Mask() string
 
+	// BatchRows returns the maximum number of rows of a multi-row insert of
+	// rows with the number of columns, which, for a single column, is also
+	// the maximum number of values of an IN list.
+	BatchRows(cols int) int
+
 	// Escape escapes the passed identifier based on its EscType.
 	Escape(EscType, string) string
 
@@ -50,6 +54,8 @@ var SchemaLoaders = map[string]Loader{}
 type TypeLoader struct {
 	ParamN          func(int) string
 	MaskFunc        func() string
+	ParamLimit      int
+	RowLimit        int
 	Esc             map[EscType]func(string) string
 	ProcessRelkind  func(RelType) string
 	Schema          func(*ArgType) (string, error)
@@ -59,7 +65,7 @@ type TypeLoader struct {
 	ProcList        func(models.XODB, string) ([]*models.Proc, error)
 	ProcParamList   func(models.XODB, string, string) ([]*models.ProcParam, error)
 	TableList       func(models.XODB, string, string) ([]*models.Table, error)
-	ColumnList      func(models.XODB, string, string) ([]*models.Column, error)
+	ColumnList      func(*ArgType, string, string) ([]*models.Column, error)
 	ForeignKeyList  func(models.XODB, string, string) ([]*models.ForeignKey, error)
 	IndexList       func(models.XODB, string, string) ([]*models.Index, error)
 	IndexColumnList func(models.XODB, string, string, string) ([]*models.IndexColumn, error)
@@ -69,11 +75,7 @@ type TypeLoader struct {
 
 func (tl TypeLoader) ParseType(args *ArgType, dt string, nullable bool) (int, string, string) {
 	if entry, ok := args.internalTypeOverrides[strings.ToLower(dt)]; ok {
-		if nullable {
-			return -1, entry.NullableNilValue, entry.NullableType
-		} else {
-			return -1, entry.NilValue, entry.Type_
-		}
+		return entry.types(nullable)
 	}
 	return tl.ParseTypeFunc(args, dt, nullable)
 }
@@ -96,6 +98,30 @@ func (tl TypeLoader) Mask() string {
 	return "$%d"
 }
 
+// BatchRows satisfies Loader's BatchRows.
+//
+// The number of rows is limited by the maximum number of params of a query,
+// ParamLimit (defaulting to 65535), and, when set, by RowLimit.
+func (tl TypeLoader) BatchRows(cols int) int {
+	limit := tl.ParamLimit
+	if limit == 0 {
+		limit = 65535
+	}
+	if cols < 1 {
+		cols = 1
+	}
+
+	n := limit / cols
+	switch {
+	case n < 1:
+		n = 1
+	case tl.RowLimit != 0 && n > tl.RowLimit:
+		n = tl.RowLimit
+	}
+
+	return n
+}
+
 // Escape escapes the provided identifier based on the EscType.
 func (tl TypeLoader) Escape(typ EscType, s string) string {
 	if e, ok := tl.Esc[typ]; ok && e != nil {
@@ -185,6 +211,9 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 				Col:  c,
 			}
 			f.Len, f.NilType, f.Type = tl.ParseType(args, c.DataType, args.QueryAllowNulls && !c.NotNull)
+			if to, ok := args.ColumnTypeOverride(args.QueryType+"."+c.ColumnName, args.QueryType+"."+f.Name); ok {
+				f.Len, f.NilType, f.Type = to.types(args.QueryAllowNulls && !c.NotNull)
+			}
 			typeTpl.Fields = append(typeTpl.Fields, f)
 		}
 	} else {
@@ -200,22 +229,20 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 				colType = qf[i+1:]
 			}
 
-			typeTpl.Fields = append(typeTpl.Fields, &Field{
+			f := &Field{
 				Name: colName,
 				Type: colType,
 				Col: &models.Column{
 					ColumnName: snaker.CamelToSnake(colName),
 				},
-			})
+			}
+			if to, ok := args.ColumnTypeOverride(args.QueryType+"."+f.Col.ColumnName, args.QueryType+"."+f.Name); ok {
+				f.Len, f.NilType, f.Type = to.types(false)
+			}
+			typeTpl.Fields = append(typeTpl.Fields, f)
 		}
 	}
 
-	// generate query type template
-	err = args.ExecuteTemplate(QueryTypeTemplate, args.QueryType, "", typeTpl)
-	if err != nil {
-		return err
-	}
-
 	// build func name
 	funcName := args.QueryFunc
 	if funcName == "" {
@@ -249,8 +276,26 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 		Comment:       args.QueryFuncComment,
 	}
 
+	// generate query type template, unless a previous query of the same type
+	// already generated it, which must have scanned the same fields
+	if prev, ok := args.queryTypes[args.QueryType]; ok {
+		if !sameQueryFields(prev.Type.Fields, typeTpl.Fields) {
+			return fmt.Errorf("query '%s' has other fields of type '%s' than query '%s'", funcName, args.QueryType, prev.Name)
+		}
+	} else {
+		err = args.ExecuteTemplate(QueryTypeTemplate, args.QueryType, "", typeTpl)
+		if err != nil {
+			return err
+		}
+
+		if args.queryTypes == nil {
+			args.queryTypes = map[string]*Query{}
+		}
+		args.queryTypes[args.QueryType] = queryTpl
+	}
+
 	// generate template
-	err = args.ExecuteTemplate(QueryTemplate, args.QueryType, "", queryTpl)
+	err = args.ExecuteTemplate(QueryTemplate, args.QueryType, funcName, queryTpl)
 	if err != nil {
 		return err
 	}
@@ -262,43 +307,79 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 func (tl TypeLoader) LoadSchema(args *ArgType) error {
 	var err error
 
-	// load enums
-	_, err = tl.LoadEnums(args)
-	if err != nil {
-		return err
+	schemas := args.Schemas
+	if len(schemas) == 0 {
+		schemas = []string{args.Schema}
 	}
 
-	// load procs
-	_, err = tl.LoadProcs(args)
-	if err != nil {
-		return err
+	tableMap := map[string]*Type{}
+	for _, schema := range schemas {
+		args.Schema = schema
+
+		// load enums
+		_, err = tl.LoadEnums(args)
+		if err != nil {
+			return err
+		}
+
+		// load procs
+		_, err = tl.LoadProcs(args)
+		if err != nil {
+			return err
+		}
+
+		// load tables
+		m, err := tl.LoadRelkind(args, Table)
+		if err != nil {
+			return err
+		}
+
+		// load views
+		viewMap, err := tl.LoadRelkind(args, View)
+		if err != nil {
+			return err
+		}
+
+		// merge tables and views with the tableMap
+		for k, v := range m {
+			tableMap[k] = v
+		}
+		for k, v := range viewMap {
+			tableMap[k] = v
+		}
 	}
+	args.Schema = schemas[0]
 
-	// load tables
-	tableMap, err := tl.LoadRelkind(args, Table)
+	// prefix the names of types sharing a table name with their schema
+	disambiguateTypeNames(tableMap)
+
+	// load indexes, before generating the table templates, as the unique
+	// indexes are the conflict targets of the generated upserts
+	ixMap, err := tl.LoadIndexes(args, tableMap)
 	if err != nil {
 		return err
 	}
 
-	// load views
-	viewMap, err := tl.LoadRelkind(args, View)
+	// generate table templates
+	err = tl.GenerateTypes(args, tableMap)
 	if err != nil {
 		return err
 	}
 
-	// merge views with the tableMap
-	for k, v := range viewMap {
-		tableMap[k] = v
+	// load foreign keys
+	fkMap, err := tl.LoadForeignKeys(args, tableMap)
+	if err != nil {
+		return err
 	}
 
-	// load foreign keys
-	_, err = tl.LoadForeignKeys(args, tableMap)
+	// load many-to-many relationships
+	_, err = tl.LoadManyToMany(args, tableMap, fkMap)
 	if err != nil {
 		return err
 	}
 
-	// load indexes
-	_, err = tl.LoadIndexes(args, tableMap)
+	// load batch loaders
+	_, err = tl.LoadBatchLoaders(args, ixMap, fkMap)
 	if err != nil {
 		return err
 	}
@@ -342,7 +423,8 @@ func (tl TypeLoader) LoadEnums(args *ArgType) (map[string]*Enum, error) {
 	}
 
 	// generate enum templates
-	for _, e := range enumMap {
+	for _, k := range sortedKeys(enumMap) {
+		e := enumMap[k]
 		err = args.ExecuteTemplate(EnumTemplate, e.Name, "", e)
 		if err != nil {
 			return nil, err
@@ -429,7 +511,8 @@ func (tl TypeLoader) LoadProcs(args *ArgType) (map[string]*Proc, error) {
 	}
 
 	// generate proc templates
-	for _, p := range procMap {
+	for _, k := range sortedKeys(procMap) {
+		p := procMap[k]
 		err = args.ExecuteTemplate(ProcTemplate, "sp_"+p.Name, "", p)
 		if err != nil {
 			return nil, err
@@ -470,7 +553,9 @@ func (tl TypeLoader) LoadProcParams(args *ArgType, procTpl *Proc) error {
 	return nil
 }
 
-// LoadRelkind loads a schema table/view definition.
+// LoadRelkind loads a schema table/view definition, keyed by the schema
+// qualified table name. The type templates are generated separately by
+// GenerateTypes, once the tables of all schemas are loaded.
 func (tl TypeLoader) LoadRelkind(args *ArgType, relType RelType) (map[string]*Type, error) {
 	var err error
 
@@ -483,16 +568,9 @@ func (tl TypeLoader) LoadRelkind(args *ArgType, relType RelType) (map[string]*Ty
 	// tables
 	tableMap := make(map[string]*Type)
 	for _, ti := range tableList {
-		ignore := false
-		for _, ignoreTable := range args.IgnoreTables {
-			if strings.ToLower(ignoreTable) == strings.ToLower(ti.TableName) {
-				// Skip adding this table if users has specified ignore
-				ignore = true
-				break
-			}
-		}
-
-		if ignore {
+		// Skip adding this table if users has specified ignore, or it is not
+		// included
+		if !args.IncludeTable(args.Schema, ti.TableName) {
 			continue
 		}
 
@@ -511,18 +589,40 @@ func (tl TypeLoader) LoadRelkind(args *ArgType, relType RelType) (map[string]*Ty
 			return nil, err
 		}
 
-		tableMap[ti.TableName] = typeTpl
+		tableMap[args.Schema+"."+ti.TableName] = typeTpl
 	}
 
-	// generate table templates
+	return tableMap, nil
+}
+
+// disambiguateTypeNames prefixes the names of the types in tableMap that
+// share a table name, but are from different schemas, with their schema name.
+func disambiguateTypeNames(tableMap map[string]*Type) {
+	// count names
+	names := map[string]int{}
 	for _, t := range tableMap {
+		names[t.Name]++
+	}
+
+	for _, k := range sortedKeys(tableMap) {
+		t := tableMap[k]
+		if names[t.Name] > 1 {
+			t.Name = snaker.SnakeToCamelIdentifier(t.Schema) + t.Name
+		}
+	}
+}
+
+// GenerateTypes generates the type templates for the loaded tables and views.
+func (tl TypeLoader) GenerateTypes(args *ArgType, tableMap map[string]*Type) error {
+	for _, k := range sortedKeys(tableMap) {
+		t := tableMap[k]
 		t.Sqlx = args.Sqlx
 		// If args.Sqlx is true, get foreign keys for current table and add to our type
 		if args.Sqlx {
-			foreignKeyList, err := tl.ForeignKeyList(args.DB, args.Schema, t.Table.TableName)
+			foreignKeyList, err := tl.ForeignKeyList(args.DB, t.Schema, t.Table.TableName)
 
 			if err != nil {
-				return nil, err
+				return err
 			}
 
 			// Hack function used to get proper foreign keys from cockroachdb
@@ -532,17 +632,17 @@ func (tl TypeLoader) LoadRelkind(args *ArgType, relType RelType) (map[string]*Ty
 			t.ForeignKeys, err = tl.GetTableForeignKeys(args, tableMap, t, nil, foreignKeyList)
 
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 
-		err = args.ExecuteTemplate(TypeTemplate, t.Name, "", t)
+		err := args.ExecuteTemplate(TypeTemplate, t.Name, "", t)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	return tableMap, nil
+	return nil
 }
 
 // LoadColumns loads schema table/view columns.
@@ -550,28 +650,20 @@ func (tl TypeLoader) LoadColumns(args *ArgType, typeTpl *Type) error {
 	var err error
 
 	// load columns
-	columnList, err := tl.ColumnList(args.DB, args.Schema, typeTpl.Table.TableName)
+	columnList, err := tl.ColumnList(args, typeTpl.Schema, typeTpl.Table.TableName)
 	if err != nil {
 		return err
 	}
 
 	// process columns
 	for _, c := range columnList {
-		ignore := false
-
-		for _, ignoreField := range args.IgnoreFields {
-			if strings.ToLower(ignoreField) == strings.ToLower(c.ColumnName) {
-				// Skip adding this field if user has specified they are not
-				// interested.
-				//
-				// This could be useful for fields which are managed by the
-				// database (e.g. automatically updated timestamps) instead of
-				// via Go code.
-				ignore = true
-			}
-		}
-
-		if ignore {
+		if !args.IncludeColumn(typeTpl.Schema, typeTpl.Table.TableName, c.ColumnName) {
+			// Skip adding this field if user has specified they are not
+			// interested.
+			//
+			// This could be useful for fields which are managed by the
+			// database (e.g. automatically updated timestamps) instead of
+			// via Go code.
 			continue
 		}
 
@@ -581,6 +673,9 @@ func (tl TypeLoader) LoadColumns(args *ArgType, typeTpl *Type) error {
 			Col:  c,
 		}
 		f.Len, f.NilType, f.Type = tl.ParseType(args, c.DataType, !c.NotNull)
+		if to, ok := args.ColumnTypeOverride(typeTpl.Schema+"."+typeTpl.Table.TableName+"."+c.ColumnName, typeTpl.Table.TableName+"."+c.ColumnName); ok {
+			f.Len, f.NilType, f.Type = to.types(!c.NotNull)
+		}
 
 		// set primary key
 		if c.IsPrimaryKey {
@@ -589,6 +684,26 @@ func (tl TypeLoader) LoadColumns(args *ArgType, typeTpl *Type) error {
 			typeTpl.PrimaryKey = f
 		}
 
+		// set soft delete column
+		if typeTpl.RelType == Table && args.SoftDeleteColumn != "" && c.ColumnName == args.SoftDeleteColumn {
+			typeTpl.SoftDelete = f
+		}
+
+		// set version column
+		if typeTpl.RelType == Table && args.VersionColumn != "" && c.ColumnName == args.VersionColumn && isIntType(f.Type) {
+			typeTpl.Version = f
+		}
+
+		// set timestamp columns
+		if typeTpl.RelType == Table && timevalue(f.Type, "now") != "" {
+			switch {
+			case containsString(args.CreatedColumns, c.ColumnName):
+				typeTpl.CreatedFields = append(typeTpl.CreatedFields, f)
+			case containsString(args.UpdatedColumns, c.ColumnName):
+				typeTpl.UpdatedFields = append(typeTpl.UpdatedFields, f)
+			}
+		}
+
 		// append col to template fields
 		typeTpl.Fields = append(typeTpl.Fields, f)
 	}
@@ -601,7 +716,8 @@ func (tl TypeLoader) LoadForeignKeys(args *ArgType, tableMap map[string]*Type) (
 	var err error
 
 	fkMap := map[string]*ForeignKey{}
-	for _, t := range tableMap {
+	for _, k := range sortedKeys(tableMap) {
+		t := tableMap[k]
 		// load keys per table
 		err = tl.LoadTableForeignKeys(args, tableMap, t, fkMap)
 		if err != nil {
@@ -610,12 +726,15 @@ func (tl TypeLoader) LoadForeignKeys(args *ArgType, tableMap map[string]*Type) (
 	}
 
 	// determine foreign key names
-	for _, fk := range fkMap {
+	for _, k := range sortedKeys(fkMap) {
+		fk := fkMap[k]
 		fk.Name = args.ForeignKeyName(fkMap, fk)
+		fk.RevName = args.ReverseForeignKeyName(fkMap, fk)
 	}
 
 	// generate templates
-	for _, fk := range fkMap {
+	for _, k := range sortedKeys(fkMap) {
+		fk := fkMap[k]
 		err = args.ExecuteTemplate(ForeignKeyTemplate, fk.Type.Name, fk.ForeignKey.ForeignKeyName, fk)
 		if err != nil {
 			return nil, err
@@ -625,12 +744,150 @@ func (tl TypeLoader) LoadForeignKeys(args *ArgType, tableMap map[string]*Type) (
 	return fkMap, nil
 }
 
+// LoadManyToMany loads the many-to-many relationships through the join tables
+// in tableMap, which are the tables with a primary key of two columns, each a
+// foreign key in fkMap.
+func (tl TypeLoader) LoadManyToMany(args *ArgType, tableMap map[string]*Type, fkMap map[string]*ForeignKey) ([]*ManyToMany, error) {
+	var err error
+
+	if *args.ManyToManyMode == ManyToManyModeNone {
+		return nil, nil
+	}
+
+	m2ms := []*ManyToMany{}
+	for _, k := range sortedKeys(tableMap) {
+		t := tableMap[k]
+		if len(t.PrimaryKeyFields) != 2 {
+			continue
+		}
+
+		// join tables with other columns are only detected when enabled
+		if len(t.Fields) != 2 && *args.ManyToManyMode != ManyToManyModeAll {
+			continue
+		}
+
+		// find the foreign keys of the primary key columns
+		var fks [2]*ForeignKey
+		for i, f := range t.PrimaryKeyFields {
+			for _, fkKey := range sortedKeys(fkMap) {
+				fk := fkMap[fkKey]
+				if fk.Type == t && fk.Field == f {
+					fks[i] = fk
+					break
+				}
+			}
+		}
+
+		// skip when not both are foreign keys, or when both are the columns of
+		// the same composite foreign key
+		if fks[0] == nil || fks[1] == nil || fks[0].ForeignKey.ForeignKeyName == fks[1].ForeignKey.ForeignKeyName {
+			continue
+		}
+
+		// add the relationship for both sides
+		m2ms = append(m2ms, &ManyToMany{
+			Type:          fks[0].RefType,
+			RefType:       fks[1].RefType,
+			JoinType:      t,
+			ForeignKey:    fks[0],
+			RefForeignKey: fks[1],
+		}, &ManyToMany{
+			Type:          fks[1].RefType,
+			RefType:       fks[0].RefType,
+			JoinType:      t,
+			ForeignKey:    fks[1],
+			RefForeignKey: fks[0],
+		})
+	}
+
+	// determine many-to-many names
+	args.ManyToManyNames(m2ms, fkMap)
+
+	// generate templates
+	for _, m := range m2ms {
+		err = args.ExecuteTemplate(ManyToManyTemplate, m.Type.Name, m.JoinType.Name+"."+m.RefForeignKey.ForeignKey.ForeignKeyName, m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m2ms, nil
+}
+
+// LoadBatchLoaders loads the batch loaders of the single column indexes,
+// other than the primary keys, in ixMap, and of both the columns and the
+// referenced columns of the foreign keys in fkMap. A column with several
+// indexes or foreign keys has one batch loader.
+func (tl TypeLoader) LoadBatchLoaders(args *ArgType, ixMap map[string]*Index, fkMap map[string]*ForeignKey) (map[string]*BatchLoader, error) {
+	var err error
+
+	blMap := map[string]*BatchLoader{}
+	add := func(t *Type, f *Field, unique bool, ix *models.Index, fk *models.ForeignKey) {
+		key := t.Schema + "." + t.Table.TableName + "." + f.Col.ColumnName
+		if bl, ok := blMap[key]; ok {
+			// values are unique when any of the indexes is unique
+			bl.Unique = bl.Unique || unique
+			return
+		}
+
+		blMap[key] = &BatchLoader{
+			FuncName:   inflector.Pluralize(t.Name) + "By" + inflector.Pluralize(f.Name),
+			ParamName:  inflector.Pluralize(paramName(f.Name)),
+			Schema:     t.Schema,
+			Type:       t,
+			Field:      f,
+			Unique:     unique,
+			Size:       tl.BatchRows(1),
+			Index:      ix,
+			ForeignKey: fk,
+		}
+	}
+
+	// unique indexes of the single columns, as a partial index is not unique
+	// across all rows, nor is a single column of a composite primary key
+	uniqueCols := map[*Field]bool{}
+	for _, k := range sortedKeys(ixMap) {
+		ix := ixMap[k]
+		if len(ix.Fields) != 1 {
+			continue
+		}
+
+		primary := ix.Index.IsPrimary || ix.Index.Origin == "pk"
+		if ix.Index.IsUnique && !ix.Index.IsPartial && (!primary || len(ix.Type.PrimaryKeyFields) < 2) {
+			uniqueCols[ix.Fields[0]] = true
+		}
+		if !primary && !sameFields(ix.Fields, ix.Type.PrimaryKeyFields) {
+			add(ix.Type, ix.Fields[0], uniqueCols[ix.Fields[0]], ix.Index, nil)
+		}
+	}
+
+	for _, k := range sortedKeys(fkMap) {
+		fk := fkMap[k]
+		add(fk.Type, fk.Field, uniqueCols[fk.Field], nil, fk.ForeignKey)
+
+		// the referenced column is unique when it is the primary key
+		refUnique := uniqueCols[fk.RefField] || (len(fk.RefType.PrimaryKeyFields) == 1 && fk.RefType.PrimaryKeyFields[0] == fk.RefField)
+		add(fk.RefType, fk.RefField, refUnique, nil, fk.ForeignKey)
+	}
+
+	// generate templates
+	for _, k := range sortedKeys(blMap) {
+		bl := blMap[k]
+		err = args.ExecuteTemplate(BatchLoaderTemplate, bl.Type.Name, bl.FuncName, bl)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return blMap, nil
+}
+
 // LoadTableForeignKeys loads schema foreign key definitions per table.
 func (tl TypeLoader) LoadTableForeignKeys(args *ArgType, tableMap map[string]*Type, typeTpl *Type, fkMap map[string]*ForeignKey) error {
 	var err error
 
 	// load foreign keys
-	foreignKeyList, err := tl.ForeignKeyList(args.DB, args.Schema, typeTpl.Table.TableName)
+	foreignKeyList, err := tl.ForeignKeyList(args.DB, typeTpl.Schema, typeTpl.Table.TableName)
 	if err != nil {
 		return err
 	}
@@ -657,7 +914,8 @@ func (tl TypeLoader) LoadIndexes(args *ArgType, tableMap map[string]*Type) (map[
 	var err error
 
 	ixMap := map[string]*Index{}
-	for _, t := range tableMap {
+	for _, k := range sortedKeys(tableMap) {
+		t := tableMap[k]
 		// load table indexes
 		err = tl.LoadTableIndexes(args, t, ixMap)
 		if err != nil {
@@ -666,7 +924,8 @@ func (tl TypeLoader) LoadIndexes(args *ArgType, tableMap map[string]*Type) (map[
 	}
 
 	// generate templates
-	for _, ix := range ixMap {
+	for _, k := range sortedKeys(ixMap) {
+		ix := ixMap[k]
 		err = args.ExecuteTemplate(IndexTemplate, ix.Type.Name, ix.Index.IndexName, ix)
 		if err != nil {
 			return nil, err
@@ -682,7 +941,7 @@ func (tl TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[st
 	var priIxLoaded bool
 
 	// load indexes
-	indexList, err := tl.IndexList(args.DB, args.Schema, typeTpl.Table.TableName)
+	indexList, err := tl.IndexList(args.DB, typeTpl.Schema, typeTpl.Table.TableName)
 	if err != nil {
 		return err
 	}
@@ -694,7 +953,7 @@ func (tl TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[st
 
 		// create index template
 		ixTpl := &Index{
-			Schema: args.Schema,
+			Schema: typeTpl.Schema,
 			Type:   typeTpl,
 			Fields: []*Field{},
 			Index:  ix,
@@ -709,7 +968,15 @@ func (tl TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[st
 		// build func name
 		args.BuildIndexFuncName(ixTpl)
 
-		ixMap[typeTpl.Table.TableName+"_"+ix.IndexName] = ixTpl
+		ixMap[typeTpl.Schema+"."+typeTpl.Table.TableName+"_"+ix.IndexName] = ixTpl
+
+		// add unique indexes, other than the primary key, as upsert conflict
+		// targets. partial indexes are skipped, as they are only conflict
+		// targets with their predicate
+		if ix.IsUnique && !ix.IsPrimary && ix.Origin != "pk" && !ix.IsPartial &&
+			len(ixTpl.Fields) != 0 && !sameFields(ixTpl.Fields, typeTpl.PrimaryKeyFields) {
+			typeTpl.UniqueIndexes = append(typeTpl.UniqueIndexes, ixTpl)
+		}
 	}
 
 	// search for primary key if it was skipped being set in the type
@@ -728,9 +995,9 @@ func (tl TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[st
 	// sqlite doesn't define primary keys in its index list
 	if args.LoaderType != "ora" && !priIxLoaded && pk != nil {
 		ixName := typeTpl.Table.TableName + "_" + pk.Col.ColumnName + "_pkey"
-		ixMap[ixName] = &Index{
+		ixMap[typeTpl.Schema+"."+ixName] = &Index{
 			FuncName: typeTpl.Name + "By" + pk.Name,
-			Schema:   args.Schema,
+			Schema:   typeTpl.Schema,
 			Type:     typeTpl,
 			Fields:   []*Field{pk},
 			Index: &models.Index{
@@ -741,6 +1008,9 @@ func (tl TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[st
 		}
 	}
 
+	// build the orders of the list func
+	args.BuildOrders(typeTpl)
+
 	return nil
 }
 
@@ -749,7 +1019,7 @@ func (tl TypeLoader) LoadIndexColumns(args *ArgType, ixTpl *Index) error {
 	var err error
 
 	// load index columns
-	indexCols, err := tl.IndexColumnList(args.DB, args.Schema, ixTpl.Type.Table.TableName, ixTpl.Index.IndexName)
+	indexCols, err := tl.IndexColumnList(args.DB, ixTpl.Schema, ixTpl.Type.Table.TableName, ixTpl.Index.IndexName)
 	if err != nil {
 		return err
 	}
@@ -803,13 +1073,21 @@ func (tl TypeLoader) GetTableForeignKeys(
 			}
 		}
 
-	refTplLoop:
-		// find ref table
-		for _, t := range tableMap {
-			if t.Table.TableName == fk.RefTableName {
-				refTpl = t
-				break refTplLoop
-			}
+		// find ref table, which is in the same schema unless otherwise
+		// specified
+		refSchema := fk.RefSchemaName
+		if refSchema == "" {
+			refSchema = typeTpl.Schema
+		}
+
+		// skip keys referencing ignored tables, or of ignored columns
+		if !args.IncludeTable(refSchema, fk.RefTableName) || !args.IncludeColumn(typeTpl.Schema, typeTpl.Table.TableName, fk.ColumnName) {
+			continue
+		}
+
+		refTpl = tableMap[refSchema+"."+fk.RefTableName]
+		if refTpl == nil {
+			return nil, fmt.Errorf("foreign key %s on %s references %s, which is not being generated", fk.ForeignKeyName, typeTpl.Table.TableName, args.schemafn(refSchema, fk.RefTableName))
 		}
 
 	refColLoop:
@@ -837,7 +1115,7 @@ func (tl TypeLoader) GetTableForeignKeys(
 		}
 
 		foreignKey := &ForeignKey{
-			Schema:     args.Schema,
+			Schema:     typeTpl.Schema,
 			Type:       typeTpl,
 			Field:      col,
 			RefType:    refTpl,
@@ -846,16 +1124,16 @@ func (tl TypeLoader) GetTableForeignKeys(
 		}
 
 		if fkMap != nil {
-			// This random generated number is included in fkmap's key name as
+			// The table and column are included in fkmap's key name as
 			// cockroachdb does not have database wide unique foreign key names
 			// so if two tables have same foreign key column name and reference
 			// same table the names are the same which get overridden in our
 			// fkmap variable
-			randNum := "_" + strconv.Itoa(rand.Int())
+			key := typeTpl.Schema + "." + typeTpl.Table.TableName + "." + fk.ForeignKeyName + "." + col.Col.ColumnName
 
 			// fkMap is used for getting all foreign keys in database to be used for
 			// foreign key functions in *.foreignkey.go.tpl
-			fkMap[fk.ForeignKeyName+randNum] = foreignKey
+			fkMap[key] = foreignKey
 		}
 
 		// foreignKeys is used if args.Sqlx is set to true and returns foreign keys
@@ -863,6 +1141,15 @@ func (tl TypeLoader) GetTableForeignKeys(
 		foreignKeys = append(foreignKeys, foreignKey)
 	}
 
+	// not all databases order the keys
+	sort.SliceStable(foreignKeys, func(i, j int) bool {
+		a, b := foreignKeys[i].ForeignKey, foreignKeys[j].ForeignKey
+		if a.ForeignKeyName != b.ForeignKeyName {
+			return a.ForeignKeyName < b.ForeignKeyName
+		}
+		return a.ColumnName < b.ColumnName
+	})
+
 	return foreignKeys, nil
 }
 
@@ -871,6 +1158,12 @@ func (tl TypeLoader) GetTableForeignKeys(
 // This is hack function for cockroachdb as cockroachdb adds foreignkeys that don't exist
 // when using the unique() function at database level
 func (tl TypeLoader) extractProperForeignKeys(fkList []*models.ForeignKey, dsn string) ([]*models.ForeignKey, error) {
+	// no dsn when generating from a snapshot, which already has the proper
+	// foreign keys
+	if dsn == "" {
+		return fkList, nil
+	}
+
 	url, err := dburl.Parse(dsn)
 
 	if err != nil {
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gedex/inflector"
+	"github.com/kenshaw/snaker"
+)
+
+// ManyToManyMode represents the different modes of detecting the join tables
+// of many-to-many relationships.
+type ManyToManyMode int
+
+const (
+	// ManyToManyModePure is the default ManyToManyMode.
+	//
+	// It detects the tables with a primary key of two columns, each a foreign
+	// key, and no other columns as join tables.
+	//
+	// For example, if you have a `books`, a `tags` and a `book_tags` join
+	// table, then the many-to-many funcs will be Book.Tags, Book.AddTag,
+	// Book.RemoveTag, Tag.Books, Tag.AddBook and Tag.RemoveBook.
+	ManyToManyModePure ManyToManyMode = iota
+
+	// ManyToManyModeAll also detects the tables with other columns than their
+	// primary key as join tables.
+	//
+	// As the other columns cannot be provided, the Add funcs are not generated
+	// for these join tables.
+	ManyToManyModeAll
+
+	// ManyToManyModeNone disables the detection of join tables.
+	ManyToManyModeNone
+)
+
+// UnmarshalText unmarshals ManyToManyMode from text.
+func (m *ManyToManyMode) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "pure", "default":
+		*m = ManyToManyModePure
+	case "all":
+		*m = ManyToManyModeAll
+	case "none":
+		*m = ManyToManyModeNone
+
+	default:
+		return errors.New("invalid ManyToManyMode")
+	}
+
+	return nil
+}
+
+// String satisfies the Stringer interface.
+func (m ManyToManyMode) String() string {
+	switch m {
+	case ManyToManyModePure:
+		return "pure"
+	case ManyToManyModeAll:
+		return "all"
+	case ManyToManyModeNone:
+		return "none"
+	}
+
+	return "unknown"
+}
+
+// m2mSuffix returns the suffix of the names of the many-to-many funcs, which
+// follow the naming of the foreign key funcs.
+func m2mSuffix(mode FkMode, m2ms []*ManyToMany, fkMap map[string]*ForeignKey, m *ManyToMany) string {
+	switch mode {
+	case FkModeParent:
+		return ""
+	case FkModeField:
+		return "By" + m.RefForeignKey.Field.Name
+	case FkModeKey:
+		return "By" + snaker.SnakeToCamelIdentifier(m.RefForeignKey.ForeignKey.ForeignKeyName)
+	}
+
+	// mode is FkModeSmart
+	// inspect all many-to-many relationships and use FkModeField if conflict
+	// found, or FkModeKey if the conflict remains
+	mode = FkModeParent
+	for _, o := range m2ms {
+		if o != m && o.Type == m.Type && o.RefType == m.RefType {
+			if o.RefForeignKey.Field.Name == m.RefForeignKey.Field.Name {
+				return m2mSuffix(FkModeKey, m2ms, fkMap, m)
+			}
+			mode = FkModeField
+		}
+	}
+
+	// reverse foreign key funcs and fields of the type are conflicts as well
+	name := inflector.Pluralize(m.RefType.Name)
+	for _, fk := range fkMap {
+		if fk.RefType == m.Type && fk.RevName == name {
+			mode = FkModeField
+		}
+	}
+	for _, f := range m.Type.Fields {
+		if f.Name == name {
+			mode = FkModeField
+		}
+	}
+
+	return m2mSuffix(mode, m2ms, fkMap, m)
+}
+
+// ManyToManyNames sets the names of the many-to-many funcs for the passed
+// many-to-many relationships.
+func (a *ArgType) ManyToManyNames(m2ms []*ManyToMany, fkMap map[string]*ForeignKey) {
+	for _, m := range m2ms {
+		suffix := m2mSuffix(*a.ForeignKeyMode, m2ms, fkMap, m)
+
+		m.Name = inflector.Pluralize(m.RefType.Name) + suffix
+		m.AddName = "Add" + m.RefType.Name + suffix
+		m.RemoveName = "Remove" + m.RefType.Name + suffix
+	}
+}
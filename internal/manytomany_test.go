@@ -0,0 +1,108 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_ManyToMany(t *testing.T) {
+	tests := []struct {
+		desc   string
+		mode   internal.ManyToManyMode
+		exp    []string
+		notExp []string
+	}{
+		{
+			desc: "pure",
+			mode: internal.ManyToManyModePure,
+			exp: []string{
+				"func (b *Book) Tags(db XODB) ([]*Tag, error) {",
+				"`FROM public.tags t ` +",
+				"`JOIN public.book_tags j ON j.tag_id = t.tag_id ` +",
+				"`WHERE j.book_id = ?`",
+				"func (b *Book) AddTag(db XODB, t *Tag) error {",
+				"func (b *Book) RemoveTag(db XODB, t *Tag) error {",
+				"func (t *Tag) Books(db XODB) ([]*Book, error) {",
+				"func (t *Tag) AddBook(db XODB, b *Book) error {",
+				"func (t *Tag) RemoveBook(db XODB, b *Book) error {",
+			},
+			notExp: []string{"EmployeesByMentorID"},
+		},
+		{
+			desc: "all",
+			mode: internal.ManyToManyModeAll,
+			exp: []string{
+				"func (b *Book) Tags(db XODB) ([]*Tag, error) {",
+				"func (b *Book) AddTag(db XODB, t *Tag) error {",
+				"func (e *Employee) EmployeesByMentorID(db XODB) ([]*Employee, error) {",
+				"func (e *Employee) RemoveEmployeeByMentorID(db XODB, eVal *Employee) error {",
+				"func (e *Employee) EmployeesByEmployeeID(db XODB) ([]*Employee, error) {",
+				"res = append(res, &eVal)\n\t}\n\terr = q.Err()",
+			},
+			notExp: []string{"AddEmployee"},
+		},
+		{
+			desc:   "none",
+			mode:   internal.ManyToManyModeNone,
+			notExp: []string{"func (b *Book) Tags(", "func (t *Tag) Books(", "EmployeesByMentorID"},
+		},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		args.ManyToManyMode = &tt.mode
+		src, err := generate(args, "sqlite3", []*internal.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "books"},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "title", DataType: "TEXT", NotNull: true},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "tags"},
+				Columns: []*models.Column{
+					{ColumnName: "tag_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "name", DataType: "TEXT", NotNull: true},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "book_tags", ManualPk: true},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "tag_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+				},
+				ForeignKeys: []*models.ForeignKey{
+					{ForeignKeyName: "book_tags_book_id_fkey", ColumnName: "book_id", RefTableName: "books", RefColumnName: "book_id"},
+					{ForeignKeyName: "book_tags_tag_id_fkey", ColumnName: "tag_id", RefTableName: "tags", RefColumnName: "tag_id"},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "employees"},
+				Columns: []*models.Column{
+					{ColumnName: "employee_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "mentorships", ManualPk: true},
+				Columns: []*models.Column{
+					{ColumnName: "employee_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "mentor_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "started_at", DataType: "TEXT"},
+				},
+				ForeignKeys: []*models.ForeignKey{
+					{ForeignKeyName: "mentorships_employee_id_fkey", ColumnName: "employee_id", RefTableName: "employees", RefColumnName: "employee_id"},
+					{ForeignKeyName: "mentorships_mentor_id_fkey", ColumnName: "mentor_id", RefTableName: "employees", RefColumnName: "employee_id"},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, tt.notExp)
+	}
+}
@@ -0,0 +1,88 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_PartialUpdates(t *testing.T) {
+	tests := []struct {
+		desc       string
+		loaderType string
+		partial    bool
+		exp        []string
+		notExp     []string
+	}{
+		{
+			desc:       "postgres",
+			loaderType: "postgres",
+			partial:    true,
+			exp: []string{
+				"_snapshot *Book",
+				"func (b *Book) snapshot() {",
+				"if b._snapshot == nil || !reflect.DeepEqual(b.Title, b._snapshot.Title) {",
+				"sets = append(sets, `title = `+fmt.Sprintf(\"$%d\", len(args)))",
+				"if len(sets) == 0 {\n\t\t\treturn nil\n\t\t}",
+				"conds = append(conds, `book_id = `+fmt.Sprintf(\"$%d\", len(args)))",
+				"conds = append(conds, `tag_id = `+fmt.Sprintf(\"$%d\", len(args)))",
+				"\tb._exists = true\n\tb.snapshot()",
+				"\tbt.snapshot()\n",
+			},
+			notExp: []string{
+				"reflect.DeepEqual(b.BookID",
+				"reflect.DeepEqual(bt.TagID",
+			},
+		},
+		{
+			desc:       "mysql",
+			loaderType: "mysql",
+			partial:    true,
+			exp: []string{
+				"sets = append(sets, `title = `+\"?\")",
+				"conds = append(conds, `tag_id = `+\"?\")",
+			},
+		},
+		{
+			desc:       "disabled",
+			loaderType: "postgres",
+			exp:        []string{"`) = ( ` +"},
+			notExp:     []string{"snapshot", "sets"},
+		},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		args.PartialUpdates = tt.partial
+		src, err := generate(args, tt.loaderType, []*internal.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "books"},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: "integer", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "title", DataType: "text", NotNull: true},
+				},
+				Indexes: []*internal.SnapshotIndex{
+					{
+						Index:   &models.Index{IndexName: "books_title_idx", IsUnique: true},
+						Columns: []*models.IndexColumn{{ColumnName: "title"}},
+					},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "book_tags", ManualPk: true},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: "integer", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "tag_id", DataType: "integer", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "note", DataType: "text"},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, tt.notExp)
+	}
+}
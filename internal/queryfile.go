@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// QueryDef is an annotated query read from a .sql file.
+type QueryDef struct {
+	// Filename and Line are the location of the query's name annotation.
+	Filename string
+	Line     int
+
+	// Func is the name of the generated query func.
+	Func string
+
+	// OnlyOne toggles the generated query func to return only one result.
+	OnlyOne bool
+
+	// Type is the name of the Go type generated from the query.
+	Type string
+
+	// Comment is the comment for the generated query func.
+	Comment string
+
+	// TypeComment is the comment for the generated query type.
+	TypeComment string
+
+	// Fields is the comma separated list of fields to scan the query results
+	// to.
+	Fields string
+
+	// Query is the query.
+	Query string
+}
+
+// ParseQueryFile parses the annotated queries in src, read from filename.
+//
+// Each query starts with a header of comments, beginning with the name of the
+// query func and optionally followed by the other annotations:
+//
+//     -- name: AuthorsByBook :many
+//     -- type: AuthorResult
+//     -- comment: AuthorsByBook returns the authors of a book.
+//     -- type-comment: AuthorResult is the result of an author query.
+//     -- fields: author_id int, name string
+//     SELECT ...
+//
+// The :one command generates a func returning a single result, and :many (the
+// default) a func returning a slice of results.
+func ParseQueryFile(filename string, src []byte) ([]*QueryDef, error) {
+	var queries []*QueryDef
+	var q *QueryDef
+	var body []string
+	inHeader := false
+
+	// end adds the current query
+	end := func() error {
+		if q == nil {
+			return nil
+		}
+		if q.Type == "" {
+			return fmt.Errorf("%s:%d: query %s is missing a type annotation", q.Filename, q.Line, q.Func)
+		}
+
+		q.Query = strings.TrimSpace(strings.Join(body, "\n"))
+		q.Query = strings.TrimSpace(strings.TrimSuffix(q.Query, ";"))
+		if q.Query == "" {
+			return fmt.Errorf("%s:%d: query %s is empty", q.Filename, q.Line, q.Func)
+		}
+
+		queries = append(queries, q)
+		return nil
+	}
+
+	s := bufio.NewScanner(bytes.NewReader(src))
+	for n := 1; s.Scan(); n++ {
+		line := s.Text()
+
+		// split annotation
+		key, val := "", ""
+		if l := strings.TrimSpace(line); strings.HasPrefix(l, "--") {
+			if i := strings.Index(l, ":"); i != -1 {
+				key = strings.TrimSpace(l[2:i])
+				val = strings.TrimSpace(l[i+1:])
+			}
+		}
+
+		switch {
+		case key == "name":
+			err := end()
+			if err != nil {
+				return nil, err
+			}
+
+			q = &QueryDef{
+				Filename: filename,
+				Line:     n,
+			}
+			body, inHeader = nil, true
+
+			f := strings.Fields(val)
+			if len(f) == 0 || len(f) > 2 {
+				return nil, fmt.Errorf("%s:%d: invalid name annotation '%s'", filename, n, val)
+			}
+			q.Func = f[0]
+			if len(f) == 2 {
+				switch f[1] {
+				case ":one":
+					q.OnlyOne = true
+				case ":many":
+				default:
+					return nil, fmt.Errorf("%s:%d: unknown query command '%s'", filename, n, f[1])
+				}
+			}
+
+		case inHeader && key == "type":
+			q.Type = val
+
+		case inHeader && key == "comment":
+			q.Comment = strings.TrimSpace(q.Comment + " " + val)
+
+		case inHeader && key == "type-comment":
+			q.TypeComment = strings.TrimSpace(q.TypeComment + " " + val)
+
+		case inHeader && key == "fields":
+			q.Fields = val
+
+		case q == nil:
+			// only comments are allowed before the first query
+			if l := strings.TrimSpace(line); l != "" && !strings.HasPrefix(l, "--") {
+				return nil, fmt.Errorf("%s:%d: query is missing a name annotation", filename, n)
+			}
+
+		default:
+			inHeader = false
+			body = append(body, line)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	err := end()
+	if err != nil {
+		return nil, err
+	}
+
+	return queries, nil
+}
+
+// LoadQueryDir parses the annotated queries in all .sql files in dir, in
+// filename order.
+func LoadQueryDir(dir string) ([]*QueryDef, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(filenames)
+
+	var queries []*QueryDef
+	for _, filename := range filenames {
+		src, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		q, err := ParseQueryFile(filename, src)
+		if err != nil {
+			return nil, err
+		}
+
+		queries = append(queries, q...)
+	}
+
+	return queries, nil
+}
+
+// SetQuery sets the query mode arguments from the annotated query.
+func (a *ArgType) SetQuery(q *QueryDef) {
+	a.Query = q.Query
+	a.QueryFunc = q.Func
+	a.QueryOnlyOne = q.OnlyOne
+	a.QueryType = q.Type
+	a.QueryFuncComment = q.Comment
+	a.QueryTypeComment = q.TypeComment
+	a.QueryFields = q.Fields
+}
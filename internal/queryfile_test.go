@@ -0,0 +1,163 @@
+package internal_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+)
+
+func Test_ParseQueryFile(t *testing.T) {
+	tests := []struct {
+		desc string
+		src  string
+		exp  []*internal.QueryDef
+		err  string
+	}{
+		{
+			desc: "single query with all annotations",
+			src: "-- name: AuthorByID :one\n" +
+				"-- type: Author\n" +
+				"-- comment: AuthorByID returns\n" +
+				"-- comment: an author.\n" +
+				"-- type-comment: Author is an author.\n" +
+				"-- fields: AuthorID int, Name string\n" +
+				"SELECT author_id, name\n" +
+				"FROM authors\n" +
+				"WHERE author_id = %%id int%%;\n",
+			exp: []*internal.QueryDef{
+				{
+					Filename:    "a.sql",
+					Line:        1,
+					Func:        "AuthorByID",
+					OnlyOne:     true,
+					Type:        "Author",
+					Comment:     "AuthorByID returns an author.",
+					TypeComment: "Author is an author.",
+					Fields:      "AuthorID int, Name string",
+					Query:       "SELECT author_id, name\nFROM authors\nWHERE author_id = %%id int%%",
+				},
+			},
+		},
+		{
+			desc: "multiple queries keep body comments",
+			src: "-- authors queries\n" +
+				"\n" +
+				"-- name: Authors :many\n" +
+				"-- type: Author\n" +
+				"-- all authors\n" +
+				"SELECT * FROM authors;\n" +
+				"\n" +
+				"-- name: Books\n" +
+				"-- type: Book\n" +
+				"SELECT * FROM books\n",
+			exp: []*internal.QueryDef{
+				{
+					Filename: "a.sql",
+					Line:     3,
+					Func:     "Authors",
+					Type:     "Author",
+					Query:    "-- all authors\nSELECT * FROM authors",
+				},
+				{
+					Filename: "a.sql",
+					Line:     8,
+					Func:     "Books",
+					Type:     "Book",
+					Query:    "SELECT * FROM books",
+				},
+			},
+		},
+		{
+			desc: "missing type",
+			src:  "-- name: Authors\nSELECT * FROM authors\n",
+			err:  "a.sql:1: query Authors is missing a type annotation",
+		},
+		{
+			desc: "missing name",
+			src:  "SELECT * FROM authors\n",
+			err:  "a.sql:1: query is missing a name annotation",
+		},
+		{
+			desc: "unknown command",
+			src:  "-- name: Authors :exec\n-- type: Author\nSELECT * FROM authors\n",
+			err:  "a.sql:1: unknown query command ':exec'",
+		},
+		{
+			desc: "empty query",
+			src:  "-- name: Authors\n-- type: Author\n",
+			err:  "a.sql:1: query Authors is empty",
+		},
+	}
+
+	for i, tt := range tests {
+		q, err := internal.ParseQueryFile("a.sql", []byte(tt.src))
+		if tt.err != "" {
+			if err == nil || err.Error() != tt.err {
+				t.Fatalf("test #%d: %s\n\texp error: %s\n\tgot: %v", i+1, tt.desc, tt.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		if !reflect.DeepEqual(q, tt.exp) {
+			t.Fatalf("test #%d: %s\n\texp: %+v\n\tgot: %+v", i+1, tt.desc, tt.exp, q)
+		}
+	}
+}
+
+func Test_ParseQuerySharedType(t *testing.T) {
+	tests := []struct {
+		desc   string
+		fields string
+		err    string
+	}{
+		{
+			desc:   "same fields",
+			fields: "AuthorID int, Name string",
+		},
+		{
+			desc:   "other fields",
+			fields: "AuthorID int",
+			err:    "query 'AuthorsByName' has other fields of type 'Author' than query 'AuthorByID'",
+		},
+		{
+			desc:   "other types",
+			fields: "AuthorID int64, Name string",
+			err:    "query 'AuthorsByName' has other fields of type 'Author' than query 'AuthorByID'",
+		},
+	}
+
+	for i, tt := range tests {
+		q, err := internal.ParseQueryFile("a.sql", []byte("-- name: AuthorByID :one\n"+
+			"-- type: Author\n"+
+			"-- fields: AuthorID int, Name string\n"+
+			"SELECT author_id, name FROM authors WHERE author_id = %%id int%%;\n\n"+
+			"-- name: AuthorsByName\n"+
+			"-- type: Author\n"+
+			"-- fields: "+tt.fields+"\n"+
+			"SELECT author_id, name FROM authors WHERE name = %%name string%%;\n"))
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		args := internal.NewDefaultArgs("")
+		args.LoaderType = "sqlite3"
+		args.Loader = internal.SchemaLoaders["sqlite3"]
+		for _, qd := range q {
+			args.SetQuery(qd)
+			err = args.Loader.ParseQuery(args)
+			if err != nil {
+				break
+			}
+		}
+		switch {
+		case tt.err == "" && err != nil:
+			t.Errorf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		case tt.err != "" && (err == nil || err.Error() != tt.err):
+			t.Errorf("test #%d: %s\n\texp error: %s\n\tgot: %v", i+1, tt.desc, tt.err, err)
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+// SnapshotVersion is the version of the snapshot file format.
+const SnapshotVersion = 1
+
+// Snapshot is a serializable copy of everything a TypeLoader discovers about
+// a schema, allowing code to be generated without a database connection.
+type Snapshot struct {
+	Version    int              `json:"version"`
+	LoaderType string           `json:"loader_type"`
+	Schemas    []string         `json:"schemas"`
+	Enums      []*SnapshotEnum  `json:"enums"`
+	Procs      []*SnapshotProc  `json:"procs"`
+	Tables     []*SnapshotTable `json:"tables"`
+}
+
+// SnapshotEnum is an enum and its values in a snapshot.
+type SnapshotEnum struct {
+	Schema string              `json:"schema"`
+	Enum   *models.Enum        `json:"enum"`
+	Values []*models.EnumValue `json:"values"`
+}
+
+// SnapshotProc is a stored procedure and its parameters in a snapshot.
+type SnapshotProc struct {
+	Schema string              `json:"schema"`
+	Proc   *models.Proc        `json:"proc"`
+	Params []*models.ProcParam `json:"params"`
+}
+
+// SnapshotTable is a table or view and its columns, foreign keys and indexes
+// in a snapshot.
+type SnapshotTable struct {
+	Schema      string               `json:"schema"`
+	Relkind     string               `json:"relkind"`
+	Table       *models.Table        `json:"table"`
+	Columns     []*models.Column     `json:"columns"`
+	ForeignKeys []*models.ForeignKey `json:"foreign_keys"`
+	Indexes     []*SnapshotIndex     `json:"indexes"`
+}
+
+// SnapshotIndex is an index and its columns in a snapshot.
+type SnapshotIndex struct {
+	Index   *models.Index         `json:"index"`
+	Columns []*models.IndexColumn `json:"columns"`
+}
+
+// Snapshot loads the ArgType.Schemas from the opened database handle into a
+// Snapshot.
+func (tl TypeLoader) Snapshot(args *ArgType) (*Snapshot, error) {
+	s := &Snapshot{
+		Version:    SnapshotVersion,
+		LoaderType: args.LoaderType,
+		Schemas:    args.Schemas,
+		Enums:      []*SnapshotEnum{},
+		Procs:      []*SnapshotProc{},
+		Tables:     []*SnapshotTable{},
+	}
+	if len(s.Schemas) == 0 {
+		s.Schemas = []string{args.Schema}
+	}
+
+	for _, schema := range s.Schemas {
+		err := tl.snapshotSchema(args, s, schema)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// not all databases order their results
+	sortSnapshot(s)
+
+	return s, nil
+}
+
+// sortSnapshot sorts the enums, procs, tables, foreign keys and indexes of the
+// snapshot by name, so that the same schema always produces the same file.
+func sortSnapshot(s *Snapshot) {
+	sort.SliceStable(s.Enums, func(i, j int) bool {
+		a, b := s.Enums[i], s.Enums[j]
+		if a.Schema != b.Schema {
+			return a.Schema < b.Schema
+		}
+		return a.Enum.EnumName < b.Enum.EnumName
+	})
+	sort.SliceStable(s.Procs, func(i, j int) bool {
+		a, b := s.Procs[i], s.Procs[j]
+		if a.Schema != b.Schema {
+			return a.Schema < b.Schema
+		}
+		return a.Proc.ProcName < b.Proc.ProcName
+	})
+	sort.SliceStable(s.Tables, func(i, j int) bool {
+		a, b := s.Tables[i], s.Tables[j]
+		if a.Schema != b.Schema {
+			return a.Schema < b.Schema
+		}
+		if a.Relkind != b.Relkind {
+			return a.Relkind < b.Relkind
+		}
+		return a.Table.TableName < b.Table.TableName
+	})
+
+	for _, t := range s.Tables {
+		sort.SliceStable(t.ForeignKeys, func(i, j int) bool {
+			a, b := t.ForeignKeys[i], t.ForeignKeys[j]
+			if a.ForeignKeyName != b.ForeignKeyName {
+				return a.ForeignKeyName < b.ForeignKeyName
+			}
+			return a.ColumnName < b.ColumnName
+		})
+		sort.SliceStable(t.Indexes, func(i, j int) bool {
+			return t.Indexes[i].Index.IndexName < t.Indexes[j].Index.IndexName
+		})
+	}
+}
+
+// snapshotSchema loads the schema from the opened database handle into the
+// snapshot.
+func (tl TypeLoader) snapshotSchema(args *ArgType, s *Snapshot, schema string) error {
+	// load enums
+	if tl.EnumList != nil {
+		enumList, err := tl.EnumList(args.DB, schema)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range enumList {
+			se := &SnapshotEnum{Schema: schema, Enum: e}
+			se.Values, err = tl.EnumValueList(args.DB, schema, e.EnumName)
+			if err != nil {
+				return err
+			}
+
+			s.Enums = append(s.Enums, se)
+		}
+	}
+
+	// load procs
+	if tl.ProcList != nil {
+		procList, err := tl.ProcList(args.DB, schema)
+		if err != nil {
+			return err
+		}
+
+		for _, p := range procList {
+			sp := &SnapshotProc{Schema: schema, Proc: p}
+			sp.Params, err = tl.ProcParamList(args.DB, schema, p.ProcName)
+			if err != nil {
+				return err
+			}
+
+			s.Procs = append(s.Procs, sp)
+		}
+	}
+
+	// load tables and views
+	for _, relType := range []RelType{Table, View} {
+		relkind := tl.Relkind(relType)
+
+		tableList, err := tl.TableList(args.DB, schema, relkind)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range tableList {
+			st := &SnapshotTable{
+				Schema:  schema,
+				Relkind: relkind,
+				Table:   t,
+				Indexes: []*SnapshotIndex{},
+			}
+
+			// load columns
+			st.Columns, err = tl.ColumnList(args, schema, t.TableName)
+			if err != nil {
+				return err
+			}
+
+			// load foreign keys
+			foreignKeyList, err := tl.ForeignKeyList(args.DB, schema, t.TableName)
+			if err != nil {
+				return err
+			}
+			st.ForeignKeys, err = tl.extractProperForeignKeys(foreignKeyList, args.DSN)
+			if err != nil {
+				return err
+			}
+
+			// load indexes
+			indexList, err := tl.IndexList(args.DB, schema, t.TableName)
+			if err != nil {
+				return err
+			}
+			for _, ix := range indexList {
+				si := &SnapshotIndex{Index: ix}
+				si.Columns, err = tl.IndexColumnList(args.DB, schema, t.TableName, ix.IndexName)
+				if err != nil {
+					return err
+				}
+
+				st.Indexes = append(st.Indexes, si)
+			}
+
+			s.Tables = append(s.Tables, st)
+		}
+	}
+
+	return nil
+}
+
+// FromSnapshot returns a copy of the TypeLoader that loads the schema from
+// the snapshot instead of a database.
+func (tl TypeLoader) FromSnapshot(s *Snapshot) TypeLoader {
+	// lookup helper
+	table := func(schema, name string) *SnapshotTable {
+		for _, t := range s.Tables {
+			if t.Schema == schema && t.Table.TableName == name {
+				return t
+			}
+		}
+		return &SnapshotTable{}
+	}
+
+	tl.Schema = func(*ArgType) (string, error) {
+		if len(s.Schemas) == 0 {
+			return "", nil
+		}
+		return s.Schemas[0], nil
+	}
+
+	if tl.EnumList != nil {
+		tl.EnumList = func(_ models.XODB, schema string) ([]*models.Enum, error) {
+			enumList := []*models.Enum{}
+			for _, e := range s.Enums {
+				if e.Schema == schema {
+					enumList = append(enumList, e.Enum)
+				}
+			}
+			return enumList, nil
+		}
+		tl.EnumValueList = func(_ models.XODB, schema string, enum string) ([]*models.EnumValue, error) {
+			for _, e := range s.Enums {
+				if e.Schema == schema && e.Enum.EnumName == enum {
+					return e.Values, nil
+				}
+			}
+			return nil, nil
+		}
+	}
+
+	if tl.ProcList != nil {
+		tl.ProcList = func(_ models.XODB, schema string) ([]*models.Proc, error) {
+			procList := []*models.Proc{}
+			for _, p := range s.Procs {
+				if p.Schema == schema {
+					procList = append(procList, p.Proc)
+				}
+			}
+			return procList, nil
+		}
+		tl.ProcParamList = func(_ models.XODB, schema string, proc string) ([]*models.ProcParam, error) {
+			for _, p := range s.Procs {
+				if p.Schema == schema && p.Proc.ProcName == proc {
+					return p.Params, nil
+				}
+			}
+			return nil, nil
+		}
+	}
+
+	tl.TableList = func(_ models.XODB, schema string, relkind string) ([]*models.Table, error) {
+		tableList := []*models.Table{}
+		for _, t := range s.Tables {
+			if t.Schema == schema && t.Relkind == relkind {
+				tableList = append(tableList, t.Table)
+			}
+		}
+		return tableList, nil
+	}
+	tl.ColumnList = func(_ *ArgType, schema string, name string) ([]*models.Column, error) {
+		return table(schema, name).Columns, nil
+	}
+	tl.ForeignKeyList = func(_ models.XODB, schema string, name string) ([]*models.ForeignKey, error) {
+		return table(schema, name).ForeignKeys, nil
+	}
+	tl.IndexList = func(_ models.XODB, schema string, name string) ([]*models.Index, error) {
+		indexList := []*models.Index{}
+		for _, ix := range table(schema, name).Indexes {
+			indexList = append(indexList, ix.Index)
+		}
+		return indexList, nil
+	}
+	tl.IndexColumnList = func(_ models.XODB, schema string, name string, index string) ([]*models.IndexColumn, error) {
+		for _, ix := range table(schema, name).Indexes {
+			if ix.Index.IndexName == index {
+				return ix.Columns, nil
+			}
+		}
+		return nil, nil
+	}
+
+	// queries need a database to be inspected
+	tl.QueryColumnList = func(*ArgType, []string) ([]*models.Column, error) {
+		return nil, errors.New("queries cannot be inspected when generating from a snapshot")
+	}
+
+	return tl
+}
+
+// WriteSnapshot writes the snapshot as JSON to the named file.
+func WriteSnapshot(filename string, s *Snapshot) error {
+	buf, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, append(buf, '\n'), 0666)
+}
+
+// ReadSnapshot reads a JSON snapshot from the named file.
+func ReadSnapshot(filename string) (*Snapshot, error) {
+	buf, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	s := new(Snapshot)
+	err = json.Unmarshal(buf, s)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+
+	if s.Version != SnapshotVersion {
+		return nil, fmt.Errorf("%s: unsupported snapshot version %d (expected %d)", filename, s.Version, SnapshotVersion)
+	}
+
+	return s, nil
+}
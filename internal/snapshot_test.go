@@ -0,0 +1,268 @@
+package internal_test
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+
+	_ "github.com/turnkey-commerce/gendal/loaders"
+)
+
+func Test_Snapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gendal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		desc string
+		snap *internal.Snapshot
+		exp  []string
+	}{
+		{
+			desc: "table with index and foreign key",
+			snap: &internal.Snapshot{
+				Version:    internal.SnapshotVersion,
+				LoaderType: "sqlite3",
+				Tables: []*internal.SnapshotTable{
+					{
+						Relkind: "table",
+						Table:   &models.Table{TableName: "authors"},
+						Columns: []*models.Column{
+							{ColumnName: "author_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+							{ColumnName: "name", DataType: "TEXT", NotNull: true, DefaultValue: sql.NullString{String: "''", Valid: true}},
+						},
+					},
+					{
+						Relkind: "table",
+						Table:   &models.Table{TableName: "books"},
+						Columns: []*models.Column{
+							{ColumnName: "book_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+							{FieldOrdinal: 1, ColumnName: "author_id", DataType: "INTEGER", NotNull: true},
+						},
+						ForeignKeys: []*models.ForeignKey{
+							{ForeignKeyName: "books_author_id_fkey", ColumnName: "author_id", RefTableName: "authors", RefColumnName: "author_id"},
+						},
+						Indexes: []*internal.SnapshotIndex{
+							{
+								Index:   &models.Index{IndexName: "books_author_id_idx"},
+								Columns: []*models.IndexColumn{{ColumnName: "author_id"}},
+							},
+						},
+					},
+				},
+			},
+			exp: []string{
+				"type Author",
+				"type Book",
+				"foreignkey Book books_author_id_fkey",
+				"index Author authors_author_id_pkey",
+				"index Book books_author_id_idx",
+				"index Book books_book_id_pkey",
+				"batchloader Author AuthorsByAuthorIDs",
+				"batchloader Book BooksByAuthorIDs",
+			},
+		},
+		{
+			desc: "view",
+			snap: &internal.Snapshot{
+				Version:    internal.SnapshotVersion,
+				LoaderType: "sqlite3",
+				Tables: []*internal.SnapshotTable{
+					{
+						Relkind: "view",
+						Table:   &models.Table{TableName: "author_names"},
+						Columns: []*models.Column{
+							{ColumnName: "name", DataType: "TEXT"},
+						},
+					},
+				},
+			},
+			exp: []string{
+				"type AuthorName",
+			},
+		},
+		{
+			desc: "tables in multiple schemas",
+			snap: &internal.Snapshot{
+				Version:    internal.SnapshotVersion,
+				LoaderType: "sqlite3",
+				Schemas:    []string{"billing", "catalog"},
+				Tables: []*internal.SnapshotTable{
+					{
+						Schema:  "catalog",
+						Relkind: "table",
+						Table:   &models.Table{TableName: "products"},
+						Columns: []*models.Column{
+							{ColumnName: "product_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+						},
+					},
+					{
+						Schema:  "catalog",
+						Relkind: "table",
+						Table:   &models.Table{TableName: "notes"},
+						Columns: []*models.Column{
+							{ColumnName: "note_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+						},
+					},
+					{
+						Schema:  "billing",
+						Relkind: "table",
+						Table:   &models.Table{TableName: "notes"},
+						Columns: []*models.Column{
+							{ColumnName: "note_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+						},
+					},
+					{
+						Schema:  "billing",
+						Relkind: "table",
+						Table:   &models.Table{TableName: "invoice_lines"},
+						Columns: []*models.Column{
+							{ColumnName: "invoice_line_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+							{FieldOrdinal: 1, ColumnName: "product_id", DataType: "INTEGER", NotNull: true},
+						},
+						ForeignKeys: []*models.ForeignKey{
+							{ForeignKeyName: "invoice_lines_product_id_fkey", ColumnName: "product_id", RefSchemaName: "catalog", RefTableName: "products", RefColumnName: "product_id"},
+						},
+					},
+				},
+			},
+			exp: []string{
+				"type Product",
+				"type CatalogNote",
+				"type BillingNote",
+				"type InvoiceLine",
+				"foreignkey InvoiceLine invoice_lines_product_id_fkey",
+				"index Product products_product_id_pkey",
+				"index CatalogNote notes_note_id_pkey",
+				"index BillingNote notes_note_id_pkey",
+				"index InvoiceLine invoice_lines_invoice_line_id_pkey",
+				"batchloader Product ProductsByProductIDs",
+				"batchloader InvoiceLine InvoiceLinesByProductIDs",
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		// round trip through a file
+		filename := filepath.Join(dir, "snapshot.json")
+		err := internal.WriteSnapshot(filename, tt.snap)
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+		s, err := internal.ReadSnapshot(filename)
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		args := internal.NewDefaultArgs("")
+		args.LoaderType = s.LoaderType
+		args.Schemas = s.Schemas
+		args.Loader = internal.SchemaLoaders[s.LoaderType].(internal.TypeLoader).FromSnapshot(s)
+
+		err = args.Loader.LoadSchema(args)
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		got := []string{}
+		for _, tb := range args.Generated {
+			g := tb.TemplateType.String() + " " + tb.Name
+			if tb.Subname != "" {
+				g += " " + tb.Subname
+			}
+			got = append(got, g)
+		}
+		sort.Strings(got)
+		sort.Strings(tt.exp)
+
+		if !reflect.DeepEqual(got, tt.exp) {
+			t.Fatalf("test #%d: %s\n\texp: %v\n\tgot: %v", i+1, tt.desc, tt.exp, got)
+		}
+	}
+}
+
+func Test_ReadSnapshotVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gendal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "snapshot.json")
+	err = ioutil.WriteFile(filename, []byte(`{"version": 99, "loader_type": "sqlite3"}`), 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = internal.ReadSnapshot(filename)
+	if err == nil {
+		t.Fatalf("expected error reading unsupported snapshot version")
+	}
+}
+
+func Test_LoadSchemaDeterministic(t *testing.T) {
+	// several tables referencing each other, so that there are enough map
+	// entries for iteration order to vary
+	s := &internal.Snapshot{
+		Version:    internal.SnapshotVersion,
+		LoaderType: "sqlite3",
+	}
+	for _, name := range []string{"authors", "books", "editors", "publishers", "reviews", "stores"} {
+		st := &internal.SnapshotTable{
+			Relkind: "table",
+			Table:   &models.Table{TableName: name},
+			Columns: []*models.Column{
+				{ColumnName: "id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+				{FieldOrdinal: 1, ColumnName: "author_id", DataType: "INTEGER"},
+				{FieldOrdinal: 2, ColumnName: "book_id", DataType: "INTEGER"},
+			},
+			ForeignKeys: []*models.ForeignKey{
+				{ColumnName: "book_id", RefTableName: "books", RefColumnName: "id"},
+				{ColumnName: "author_id", RefTableName: "authors", RefColumnName: "id"},
+			},
+			Indexes: []*internal.SnapshotIndex{
+				{
+					Index:   &models.Index{IndexName: name + "_book_id_idx"},
+					Columns: []*models.IndexColumn{{ColumnName: "book_id"}},
+				},
+				{
+					Index:   &models.Index{IndexName: name + "_author_id_idx"},
+					Columns: []*models.IndexColumn{{ColumnName: "author_id"}},
+				},
+			},
+		}
+		s.Tables = append(s.Tables, st)
+	}
+
+	var exp string
+	for i := 0; i < 10; i++ {
+		args := internal.NewDefaultArgs("")
+		args.LoaderType = s.LoaderType
+		args.Sqlx = true
+		args.Loader = internal.SchemaLoaders[s.LoaderType].(internal.TypeLoader).FromSnapshot(s)
+
+		err := args.Loader.LoadSchema(args)
+		if err != nil {
+			t.Fatalf("run #%d: unexpected error: %v", i+1, err)
+		}
+
+		got := ""
+		for _, tb := range args.Generated {
+			got += tb.TemplateType.String() + " " + tb.Name + " " + tb.Subname + "\n" + tb.Buf.String()
+		}
+		if i == 0 {
+			exp = got
+		} else if got != exp {
+			t.Fatalf("run #%d: generated output differs from the first run", i+1)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_SoftDelete(t *testing.T) {
+	tests := []struct {
+		desc       string
+		loaderType string
+		markerType string
+		notNull    bool
+		column     string
+		exp        []string
+		notExp     []string
+	}{
+		{
+			desc:       "postgres timestamp",
+			loaderType: "postgres",
+			markerType: "timestamp with time zone",
+			column:     "deleted_at",
+			exp: []string{
+				"return b._exists && !b._deleted",
+				"now := XONow()",
+				"`UPDATE public.books SET deleted_at = $1 WHERE book_id = $2`",
+				"XOLog(sqlstr, pq.NullTime{Time: now, Valid: true}, b.BookID)",
+				"b.DeletedAt = pq.NullTime{Time: now, Valid: true}",
+				"func (b *Book) Restore(db XODB) error {",
+				"`UPDATE public.books SET deleted_at = NULL WHERE book_id = $1`",
+				"b.DeletedAt = pq.NullTime{}",
+				"func (b *Book) HardDelete(db XODB) error {",
+				"`DELETE FROM public.books WHERE book_id = $1`",
+				"`WHERE title = $1 AND deleted_at IS NULL`",
+				"func BookByTitleIncludingDeleted(db XODB, title string) (*Book, error) {",
+				"`book_id, author_id, title, deleted_at, CASE WHEN deleted_at IS NULL THEN 0 ELSE 1 END ` +",
+				", &b._deleted)",
+				"func (a *Author) BooksIncludingDeleted(db XODB) ([]*Book, error) {",
+				"`WHERE author_id = $1 AND deleted_at IS NULL`",
+				"func BooksByAuthorIDsIncludingDeleted(db XODB, authorIDs []int) (map[int][]*Book, error) {",
+				"`WHERE author_id = ANY($1) AND deleted_at IS NULL`",
+				"IncludingDeleted bool",
+				"filters = append(filters, `deleted_at IS NULL`)",
+				"func (q *BookQuery) IncludingDeleted() *BookQuery {",
+			},
+			notExp: []string{
+				"CURRENT_TIMESTAMP",
+				"func (a *Author) Restore(",
+				"func (b *Book) AuthorIncludingDeleted(",
+				"AuthorByAuthorIDIncludingDeleted",
+			},
+		},
+		{
+			desc:       "mysql boolean",
+			loaderType: "mysql",
+			markerType: "bool",
+			notNull:    true,
+			column:     "is_deleted",
+			exp: []string{
+				"`UPDATE public.books SET is_deleted = 1 WHERE book_id = ?`",
+				"b.IsDeleted = true",
+				"`UPDATE public.books SET is_deleted = 0 WHERE book_id = ?`",
+				"b.IsDeleted = false",
+				"`WHERE title = ? AND is_deleted = 0`",
+			},
+		},
+		{
+			desc:       "postgres nullable boolean",
+			loaderType: "postgres",
+			markerType: "boolean",
+			column:     "is_deleted",
+			exp: []string{
+				"`UPDATE public.books SET is_deleted = true WHERE book_id = $1`",
+				"b.IsDeleted = sql.NullBool{Bool: true, Valid: true}",
+				"`WHERE title = $1 AND (is_deleted IS NULL OR is_deleted = false)`",
+			},
+		},
+		{
+			desc:       "disabled",
+			loaderType: "postgres",
+			markerType: "timestamp with time zone",
+			exp: []string{
+				"return b._exists\n",
+				"`DELETE FROM public.books WHERE book_id = $1`",
+			},
+			notExp: []string{"HardDelete", "Restore", "IncludingDeleted", "CASE WHEN"},
+		},
+	}
+
+	for i, tt := range tests {
+		column := tt.column
+		if column == "" {
+			column = "deleted_at"
+		}
+
+		args := internal.NewDefaultArgs("")
+		args.SoftDeleteColumn = tt.column
+		src, err := generate(args, tt.loaderType, []*internal.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "authors"},
+				Columns: []*models.Column{
+					{ColumnName: "author_id", DataType: "integer", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "name", DataType: "text", NotNull: true},
+				},
+			},
+			{
+				Table: &models.Table{TableName: "books"},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: "integer", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "author_id", DataType: "integer", NotNull: true},
+					{FieldOrdinal: 2, ColumnName: "title", DataType: "text", NotNull: true},
+					{FieldOrdinal: 3, ColumnName: column, DataType: tt.markerType, NotNull: tt.notNull},
+				},
+				ForeignKeys: []*models.ForeignKey{
+					{ForeignKeyName: "books_author_id_fkey", ColumnName: "author_id", RefTableName: "authors", RefColumnName: "author_id"},
+				},
+				Indexes: []*internal.SnapshotIndex{
+					{
+						Index:   &models.Index{IndexName: "books_title_idx", IsUnique: true},
+						Columns: []*models.IndexColumn{{ColumnName: "title"}},
+					},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, tt.notExp)
+	}
+}
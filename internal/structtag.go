@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kenshaw/snaker"
+)
+
+// StructTag is a struct tag added to the fields of the generated types.
+type StructTag struct {
+	// Name is the key of the tag (ie, 'db', 'json', 'yaml' or 'xml').
+	Name string
+
+	// Naming is the naming strategy of the tag value. It is one of 'raw' (the
+	// column name, the default), 'snake' or 'camel' (both derived from the
+	// Go field name).
+	Naming string
+
+	// OmitEmpty toggles appending ',omitempty' to the tag value.
+	OmitEmpty bool
+}
+
+// StructTagOverride overrides the value of a struct tag for a column.
+type StructTagOverride struct {
+	// Column is the name of the column, in the form of 'table.column' (or
+	// 'schema.table.column'), or 'QueryType.field' in query mode.
+	Column string
+
+	// Tag is the name of the tag to override.
+	Tag string
+
+	// Value is the complete value of the tag (ie, '-' or 'email,omitempty').
+	// When empty, the tag is omitted from the field.
+	Value string
+}
+
+// DefaultStructTags are the struct tags added to the fields of the generated
+// types when none are configured.
+var DefaultStructTags = []StructTag{
+	{Name: "json", Naming: "raw"},
+}
+
+// PopulateStructTags checks the configured struct tags and struct tag
+// overrides.
+func (args *ArgType) PopulateStructTags() error {
+	tags := args.StructTags
+	if len(tags) == 0 {
+		tags = DefaultStructTags
+	}
+
+	names := map[string]bool{}
+	for _, st := range tags {
+		if st.Name == "" || strings.ContainsAny(st.Name, " :\"`") {
+			return fmt.Errorf("invalid struct tag name '%s'", st.Name)
+		}
+		if names[st.Name] {
+			return fmt.Errorf("duplicate struct tag '%s'", st.Name)
+		}
+		names[st.Name] = true
+
+		switch strings.ToLower(st.Naming) {
+		case "", "raw", "snake", "camel":
+		default:
+			return fmt.Errorf("invalid naming '%s' for struct tag '%s' [values: <raw|snake|camel>]", st.Naming, st.Name)
+		}
+	}
+
+	args.internalStructTagOverrides = map[string]StructTagOverride{}
+	for _, o := range args.StructTagOverrides {
+		if !names[o.Tag] {
+			return fmt.Errorf("struct tag override for tag '%s' of %s, which is not a configured struct tag", o.Tag, o.Column)
+		}
+
+		key := strings.ToLower(o.Column) + " " + o.Tag
+		if _, ok := args.internalStructTagOverrides[key]; ok {
+			return fmt.Errorf("duplicate struct tag override for tag '%s' of %s", o.Tag, o.Column)
+		}
+		args.internalStructTagOverrides[key] = o
+	}
+
+	return nil
+}
+
+// fieldtag returns the struct tags of the field of the type, including the
+// enclosing backticks, or an empty string if the field has no tags.
+func (a *ArgType) fieldtag(t *Type, f *Field) string {
+	tags := a.StructTags
+	if len(tags) == 0 {
+		tags = DefaultStructTags
+	}
+
+	// names of the column the overrides may use, most specific first
+	columns := []string{}
+	if t.Table != nil {
+		columns = append(columns,
+			t.Schema+"."+t.Table.TableName+"."+f.Col.ColumnName,
+			t.Table.TableName+"."+f.Col.ColumnName,
+		)
+	}
+	columns = append(columns,
+		t.Name+"."+f.Name,
+		t.Name+"."+f.Col.ColumnName,
+	)
+
+	values := []string{}
+	for _, st := range tags {
+		var v string
+		switch strings.ToLower(st.Naming) {
+		case "snake":
+			v = snaker.CamelToSnake(f.Name)
+		case "camel":
+			v = snaker.ForceLowerCamelIdentifier(f.Name)
+		default:
+			v = f.Col.ColumnName
+		}
+		if st.OmitEmpty {
+			v += ",omitempty"
+		}
+
+		// apply override
+		for _, c := range columns {
+			if o, ok := a.internalStructTagOverrides[strings.ToLower(c)+" "+st.Name]; ok {
+				v = o.Value
+				break
+			}
+		}
+
+		if v != "" {
+			values = append(values, st.Name+`:"`+v+`"`)
+		}
+	}
+
+	if len(values) == 0 {
+		return ""
+	}
+
+	return "`" + strings.Join(values, " ") + "`"
+}
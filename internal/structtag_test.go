@@ -0,0 +1,123 @@
+package internal_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_StructTags(t *testing.T) {
+	tests := []struct {
+		desc      string
+		tags      []internal.StructTag
+		overrides []internal.StructTagOverride
+		exp       []string
+	}{
+		{
+			desc: "default json tag",
+			exp: []string{
+				"UserID int `json:\"user_id\"`",
+				"DisplayName string `json:\"display_name\"`",
+			},
+		},
+		{
+			desc: "naming strategies and omitempty",
+			tags: []internal.StructTag{
+				{Name: "db"},
+				{Name: "json", Naming: "camel", OmitEmpty: true},
+				{Name: "yaml", Naming: "snake"},
+			},
+			exp: []string{
+				"UserID int `db:\"user_id\" json:\"userID,omitempty\" yaml:\"user_id\"`",
+				"DisplayName string `db:\"display_name\" json:\"displayName,omitempty\" yaml:\"display_name\"`",
+			},
+		},
+		{
+			desc: "column overrides",
+			tags: []internal.StructTag{
+				{Name: "db"},
+				{Name: "json"},
+			},
+			overrides: []internal.StructTagOverride{
+				{Column: "users.password_hash", Tag: "json", Value: "-"},
+				{Column: "Users.Display_Name", Tag: "db", Value: ""},
+			},
+			exp: []string{
+				"PasswordHash string `db:\"password_hash\" json:\"-\"`",
+				"DisplayName string `json:\"display_name\"`",
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		s := &internal.Snapshot{
+			Version:    internal.SnapshotVersion,
+			LoaderType: "sqlite3",
+			Tables: []*internal.SnapshotTable{
+				{
+					Relkind: "table",
+					Table:   &models.Table{TableName: "users"},
+					Columns: []*models.Column{
+						{ColumnName: "user_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+						{FieldOrdinal: 1, ColumnName: "display_name", DataType: "TEXT", NotNull: true},
+						{FieldOrdinal: 2, ColumnName: "password_hash", DataType: "TEXT", NotNull: true},
+					},
+				},
+			},
+		}
+
+		args := internal.NewDefaultArgs("")
+		args.LoaderType = s.LoaderType
+		args.Loader = internal.SchemaLoaders[s.LoaderType].(internal.TypeLoader).FromSnapshot(s)
+		args.StructTags = tt.tags
+		args.StructTagOverrides = tt.overrides
+		err := args.PopulateStructTags()
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		err = args.Loader.LoadSchema(args)
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		var src string
+		for _, tb := range args.Generated {
+			if tb.TemplateType == internal.TypeTemplate {
+				src = tb.Buf.String()
+			}
+		}
+
+		for _, exp := range tt.exp {
+			if !regexp.MustCompile(`\s` + regexp.QuoteMeta(exp) + `\s`).MatchString(src) {
+				t.Errorf("test #%d: %s\n\texpected generated type to contain %q, got:\n%s", i+1, tt.desc, exp, src)
+			}
+		}
+	}
+}
+
+func Test_PopulateStructTagsError(t *testing.T) {
+	tests := []struct {
+		desc      string
+		tags      []internal.StructTag
+		overrides []internal.StructTagOverride
+	}{
+		{"invalid naming", []internal.StructTag{{Name: "json", Naming: "kebab"}}, nil},
+		{"duplicate tag", []internal.StructTag{{Name: "json"}, {Name: "json"}}, nil},
+		{"empty name", []internal.StructTag{{Naming: "snake"}}, nil},
+		{"duplicate override", nil, []internal.StructTagOverride{{Column: "books.isbn", Tag: "json"}, {Column: "books.isbn", Tag: "json", Value: "-"}}},
+		{"override of unconfigured tag", []internal.StructTag{{Name: "db"}}, []internal.StructTagOverride{{Column: "books.isbn", Tag: "json", Value: "-"}}},
+		{"override of unconfigured default tag", nil, []internal.StructTagOverride{{Column: "books.isbn", Tag: "db", Value: "-"}}},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		args.StructTags = tt.tags
+		args.StructTagOverrides = tt.overrides
+		if err := args.PopulateStructTags(); err == nil {
+			t.Errorf("test #%d: %s\n\texpected error", i+1, tt.desc)
+		}
+	}
+}
@@ -5,29 +5,22 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"path"
+	"os"
+	"path/filepath"
 	"text/template"
 
-	templates "github.com/turnkey-commerce/gendal/tplbin"
+	"github.com/turnkey-commerce/gendal/templates"
 )
 
-// TemplateLoader loads templates from the specified name.
-func (a *ArgType) TemplateLoader(name string) ([]byte, error) {
-	// no template path specified
-	if a.TemplatePath == "" {
-		return templates.Asset(name)
-	}
-
-	return ioutil.ReadFile(path.Join(a.TemplatePath, name))
-}
-
 // TemplateSet retrieves the created template set.
 func (a *ArgType) TemplateSet() *TemplateSet {
 	if a.templateSet == nil {
 		a.templateSet = &TemplateSet{
-			funcs: a.NewTemplateFuncs(),
-			l:     a.TemplateLoader,
-			tpls:  map[string]*template.Template{},
+			funcs:        a.NewTemplateFuncs(),
+			l:            templates.Asset,
+			path:         a.TemplatePath,
+			partialsPath: a.PartialsPath,
+			tpls:         map[string]*template.Template{},
 		}
 	}
 
@@ -64,6 +57,7 @@ func (a *ArgType) ExecuteTemplate(tt TemplateType, name string, sub string, obj
 		}
 	}
 	templateName := fmt.Sprintf("%s%s.go.tpl", loaderType, tt)
+	v.Template = templateName
 
 	// execute template
 	err = a.TemplateSet().Execute(v.Buf, templateName, obj)
@@ -72,14 +66,18 @@ func (a *ArgType) ExecuteTemplate(tt TemplateType, name string, sub string, obj
 	}
 
 	a.Generated = append(a.Generated, v)
-	return nil
+
+	// execute extra templates for the item
+	return a.executeExtraTemplates(tt, obj)
 }
 
 // TemplateSet is a set of templates.
 type TemplateSet struct {
-	funcs template.FuncMap
-	l     func(string) ([]byte, error)
-	tpls  map[string]*template.Template
+	funcs        template.FuncMap
+	l            func(string) ([]byte, error)
+	path         string
+	partialsPath string
+	tpls         map[string]*template.Template
 }
 
 // Execute executes a specified template in the template set using the supplied
@@ -87,18 +85,79 @@ type TemplateSet struct {
 func (ts *TemplateSet) Execute(w io.Writer, name string, obj interface{}) error {
 	tpl, ok := ts.tpls[name]
 	if !ok {
+		var err error
+
 		// attempt to load and parse the template
-		buf, err := ts.l(name)
+		tpl, err = ts.load(name)
 		if err != nil {
 			return err
 		}
+		ts.tpls[name] = tpl
+	}
 
-		// parse template
-		tpl, err = template.New(name).Funcs(ts.funcs).Parse(string(buf))
+	return tpl.Execute(w, obj)
+}
+
+// load loads and parses the template with name.
+//
+// The built in template is parsed first, followed by the shared partials and
+// then the user supplied template from the template path, so that the blocks
+// defined by the partials and the user template replace the blocks of the
+// built in template. A user template that only defines blocks keeps the body
+// of the built in template.
+func (ts *TemplateSet) load(name string) (*template.Template, error) {
+	tpl := template.New(name).Funcs(ts.funcs)
+	found := false
+
+	// built in template
+	buf, err := ts.l(name)
+	if err == nil {
+		_, err = tpl.Parse(string(buf))
 		if err != nil {
-			return err
+			return nil, err
 		}
+		found = true
 	}
 
-	return tpl.Execute(w, obj)
+	// shared partials
+	if ts.partialsPath != "" {
+		files, err := filepath.Glob(filepath.Join(ts.partialsPath, "*.tpl"))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			buf, err := ioutil.ReadFile(f)
+			if err != nil {
+				return nil, err
+			}
+
+			// parse as its own template, so that any text outside of the
+			// block definitions does not replace the body
+			_, err = tpl.New(filepath.Base(f)).Parse(string(buf))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// user template
+	if ts.path != "" {
+		buf, err := ioutil.ReadFile(filepath.Join(ts.path, name))
+		switch {
+		case err == nil:
+			_, err = tpl.Parse(string(buf))
+			if err != nil {
+				return nil, err
+			}
+			found = true
+		case !os.IsNotExist(err):
+			return nil, err
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("template %s not found", name)
+	}
+
+	return tpl, nil
 }
@@ -0,0 +1,135 @@
+package internal_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_TemplateBlocks(t *testing.T) {
+	tests := []struct {
+		desc     string
+		user     string
+		partials map[string]string
+		exp      []string
+		notExp   []string
+	}{
+		{
+			desc: "built in blocks",
+			exp:  []string{"type User struct", "Insert(db XODB)", "Update(db XODB)", "Delete(db XODB)"},
+		},
+		{
+			desc: "user template overrides a block",
+			user: `{{ define "delete" }}// Delete is disabled for {{ .Name }}.{{ end }}`,
+			exp:  []string{"type User struct", "Insert(db XODB)", "// Delete is disabled for User."},
+			notExp: []string{
+				"Delete(db XODB)",
+			},
+		},
+		{
+			desc: "partial adds a block",
+			partials: map[string]string{
+				"upsert.tpl": `{{ define "upsert" }}
+// Upsert is from a partial for {{ .Name }}.
+{{ end }}text outside of blocks is ignored`,
+			},
+			exp:    []string{"Update(db XODB)", "Delete(db XODB)", "// Upsert is from a partial for User."},
+			notExp: []string{"text outside of blocks"},
+		},
+		{
+			desc: "user template takes precedence over partials",
+			user: `{{ define "struct" }}// user struct{{ end }}`,
+			partials: map[string]string{
+				"struct.tpl": `{{ define "struct" }}// partial struct{{ end }}`,
+			},
+			exp:    []string{"// user struct", "Insert(db XODB)"},
+			notExp: []string{"type User struct", "// partial struct"},
+		},
+		{
+			desc: "user template replaces the body",
+			user: `// only the user template`,
+			exp:  []string{"// only the user template"},
+			notExp: []string{
+				"type User struct",
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		dir, err := ioutil.TempDir("", "gendal")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		args := internal.NewDefaultArgs("")
+		args.LoaderType = "sqlite3"
+		args.Loader = internal.SchemaLoaders["sqlite3"].(internal.TypeLoader).FromSnapshot(&internal.Snapshot{
+			Version:    internal.SnapshotVersion,
+			LoaderType: "sqlite3",
+			Tables: []*internal.SnapshotTable{
+				{
+					Relkind: "table",
+					Table:   &models.Table{TableName: "users"},
+					Columns: []*models.Column{
+						{ColumnName: "user_id", DataType: "INTEGER", NotNull: true, IsPrimaryKey: true},
+						{FieldOrdinal: 1, ColumnName: "name", DataType: "TEXT", NotNull: true},
+					},
+				},
+			},
+		})
+
+		if tt.user != "" {
+			args.TemplatePath = filepath.Join(dir, "templates")
+			err = os.Mkdir(args.TemplatePath, 0777)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = ioutil.WriteFile(filepath.Join(args.TemplatePath, "sqlite3.type.go.tpl"), []byte(tt.user), 0666)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if tt.partials != nil {
+			args.PartialsPath = filepath.Join(dir, "partials")
+			err = os.Mkdir(args.PartialsPath, 0777)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, src := range tt.partials {
+				err = ioutil.WriteFile(filepath.Join(args.PartialsPath, name), []byte(src), 0666)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+
+		err = args.Loader.LoadSchema(args)
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		var src string
+		for _, tb := range args.Generated {
+			if tb.TemplateType == internal.TypeTemplate {
+				src = tb.Buf.String()
+			}
+		}
+
+		for _, exp := range tt.exp {
+			if !strings.Contains(src, exp) {
+				t.Errorf("test #%d: %s\n\texpected generated type to contain %q, got:\n%s", i+1, tt.desc, exp, src)
+			}
+		}
+		for _, exp := range tt.notExp {
+			if strings.Contains(src, exp) {
+				t.Errorf("test #%d: %s\n\texpected generated type to not contain %q, got:\n%s", i+1, tt.desc, exp, src)
+			}
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_Timestamps(t *testing.T) {
+	tests := []struct {
+		desc       string
+		loaderType string
+		timeType   string
+		partial    bool
+		columns    bool
+		softDelete bool
+		exp        []string
+		notExp     []string
+	}{
+		{
+			desc:       "postgres",
+			loaderType: "postgres",
+			timeType:   "timestamp with time zone",
+			columns:    true,
+			exp: []string{
+				"var XONow = time.Now",
+				"now := XONow()\n\tb.CreatedAt = now\n\tb.UpdatedAt = pq.NullTime{Time: now, Valid: true}\n",
+				"now := XONow()\n\t\tb.UpdatedAt = pq.NullTime{Time: now, Valid: true}\n",
+				"`book_id = EXCLUDED.book_id, title = EXCLUDED.title, updated_at = EXCLUDED.updated_at` +",
+				"` RETURNING created_at`",
+				".Scan(&b.CreatedAt)",
+				"` RETURNING book_id, created_at`",
+				".Scan(&b.BookID, &b.CreatedAt)",
+			},
+			notExp: []string{
+				"created_at = EXCLUDED.created_at",
+			},
+		},
+		{
+			desc:       "postgres partial",
+			loaderType: "postgres",
+			timeType:   "timestamp with time zone",
+			partial:    true,
+			columns:    true,
+			exp: []string{
+				"args = append(args, b.UpdatedAt)",
+				"sets = append(sets, `updated_at = `+fmt.Sprintf(\"$%d\", len(args)))",
+			},
+			notExp: []string{
+				"reflect.DeepEqual(b.UpdatedAt",
+			},
+		},
+		{
+			desc:       "postgres soft delete",
+			loaderType: "postgres",
+			timeType:   "timestamp with time zone",
+			partial:    true,
+			columns:    true,
+			softDelete: true,
+			exp: []string{
+				"`UPDATE public.books SET deleted_at = $1, updated_at = $2 WHERE book_id = $3`",
+				"XOLog(sqlstr, pq.NullTime{Time: now, Valid: true}, b.UpdatedAt, b.BookID)",
+				"`UPDATE public.books SET deleted_at = NULL, updated_at = $1 WHERE book_id = $2`",
+				"XOLog(sqlstr, b.UpdatedAt, b.BookID)",
+				"b._snapshot.DeletedAt = pq.NullTime{Time: now, Valid: true}",
+				"b._snapshot.UpdatedAt = b.UpdatedAt",
+			},
+		},
+		{
+			desc:       "mssql",
+			loaderType: "mssql",
+			timeType:   "datetime",
+			columns:    true,
+			exp: []string{
+				"b.CreatedAt = now",
+				"`OUTPUT inserted.created_at;`",
+				"`OUTPUT inserted.book_id, inserted.created_at;`",
+			},
+			notExp: []string{
+				"created_at = s.created_at",
+			},
+		},
+		{
+			desc:       "sqlite3",
+			loaderType: "sqlite3",
+			timeType:   "DATETIME",
+			columns:    true,
+			exp: []string{
+				"b.CreatedAt = xoutil.SqTime{Time: now}",
+				"`SELECT book_id, created_at ` +",
+			},
+		},
+		{
+			desc:       "disabled",
+			loaderType: "postgres",
+			timeType:   "timestamp with time zone",
+			exp: []string{
+				"title = EXCLUDED.title, created_at = EXCLUDED.created_at",
+			},
+			notExp: []string{"XONow", "set timestamps"},
+		},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		args.PartialUpdates = tt.partial
+		if tt.columns {
+			args.CreatedColumns = []string{"created_at"}
+			args.UpdatedColumns = []string{"updated_at"}
+		}
+		columns := []*models.Column{
+			{ColumnName: "book_id", DataType: "integer", NotNull: true, IsPrimaryKey: true},
+			{FieldOrdinal: 1, ColumnName: "title", DataType: "text", NotNull: true},
+			{FieldOrdinal: 2, ColumnName: "created_at", DataType: tt.timeType, NotNull: true},
+			{FieldOrdinal: 3, ColumnName: "updated_at", DataType: tt.timeType},
+		}
+		if tt.softDelete {
+			args.SoftDeleteColumn = "deleted_at"
+			columns = append(columns, &models.Column{FieldOrdinal: 4, ColumnName: "deleted_at", DataType: tt.timeType})
+		}
+		src, err := generate(args, tt.loaderType, []*internal.SnapshotTable{
+			{
+				Table:   &models.Table{TableName: "books"},
+				Columns: columns,
+				Indexes: []*internal.SnapshotIndex{
+					{
+						Index:   &models.Index{IndexName: "books_title_idx", IsUnique: true},
+						Columns: []*models.IndexColumn{{ColumnName: "title"}},
+					},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, tt.notExp)
+	}
+}
+
+func Test_TimestampsCreatedOnly(t *testing.T) {
+	tests := []struct {
+		desc       string
+		loaderType string
+		timeType   string
+		manualPk   bool
+		exp        []string
+		notExp     []string
+	}{
+		{
+			desc:       "postgres",
+			loaderType: "postgres",
+			timeType:   "timestamp with time zone",
+			exp: []string{
+				"`) ON CONFLICT (event_id) DO UPDATE SET ` +\n\t\t\t`event_id = EXCLUDED.event_id` +",
+				"`) ON CONFLICT (created_at) DO UPDATE SET ` +\n\t\t\t`created_at = EXCLUDED.created_at` +",
+			},
+		},
+		{
+			desc:       "mysql",
+			loaderType: "mysql",
+			timeType:   "datetime",
+			exp: []string{
+				"`event_id = LAST_INSERT_ID(event_id)`",
+			},
+			notExp: []string{"LAST_INSERT_ID(event_id), "},
+		},
+		{
+			desc:       "mysql manual primary key",
+			loaderType: "mysql",
+			timeType:   "datetime",
+			manualPk:   true,
+			exp: []string{
+				"`) ON DUPLICATE KEY UPDATE ` +\n\t\t\t`event_id = event_id`",
+				"`) ON DUPLICATE KEY UPDATE ` +\n\t\t\t`created_at = created_at`",
+			},
+		},
+		{
+			desc:       "sqlite3",
+			loaderType: "sqlite3",
+			timeType:   "DATETIME",
+			manualPk:   true,
+			exp: []string{
+				"`) ON CONFLICT (event_id) DO UPDATE SET ` +\n\t\t\t`event_id = EXCLUDED.event_id`",
+				"`) ON CONFLICT (created_at) DO UPDATE SET ` +\n\t\t\t`created_at = EXCLUDED.created_at`",
+			},
+		},
+		{
+			desc:       "mssql",
+			loaderType: "mssql",
+			timeType:   "datetime",
+			manualPk:   true,
+			exp: []string{
+				"`WHEN MATCHED THEN UPDATE SET event_id = s.event_id ` +",
+				"`WHEN MATCHED THEN UPDATE SET created_at = s.created_at ` +",
+			},
+		},
+		{
+			desc:       "oracle",
+			loaderType: "ora",
+			timeType:   "timestamp",
+			manualPk:   true,
+			exp: []string{
+				"func (e *Event) Upsert(db XODB) error {",
+			},
+			notExp: []string{"WHEN MATCHED"},
+		},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		args.CreatedColumns = []string{"created_at"}
+		src, err := generate(args, tt.loaderType, []*internal.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "events", ManualPk: tt.manualPk},
+				Columns: []*models.Column{
+					{ColumnName: "event_id", DataType: "integer", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "created_at", DataType: tt.timeType, NotNull: true},
+				},
+				Indexes: []*internal.SnapshotIndex{
+					{
+						Index:   &models.Index{IndexName: "events_created_idx", IsUnique: true},
+						Columns: []*models.IndexColumn{{ColumnName: "created_at"}},
+					},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, tt.notExp)
+	}
+}
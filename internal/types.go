@@ -11,10 +11,15 @@ const (
 	ProcTemplate
 	TypeTemplate
 	ForeignKeyTemplate
+	ManyToManyTemplate
 	IndexTemplate
+	BatchLoaderTemplate
 	QueryTypeTemplate
 	QueryTemplate
 
+	// user supplied extra templates
+	UserTemplate
+
 	// always last
 	XOTemplate
 )
@@ -33,12 +38,18 @@ func (tt TemplateType) String() string {
 		s = "type"
 	case ForeignKeyTemplate:
 		s = "foreignkey"
+	case ManyToManyTemplate:
+		s = "manytomany"
 	case IndexTemplate:
 		s = "index"
+	case BatchLoaderTemplate:
+		s = "batchloader"
 	case QueryTypeTemplate:
 		s = "querytype"
 	case QueryTemplate:
 		s = "query"
+	case UserTemplate:
+		s = "user"
 	default:
 		panic("unknown TemplateType")
 	}
@@ -130,11 +141,42 @@ type Type struct {
 	Comment          string
 	ForeignKeys      []*ForeignKey
 	Sqlx             bool
+
+	// UniqueIndexes are the unique indexes of the table, other than the
+	// primary key, which are the conflict targets of the generated upserts.
+	UniqueIndexes []*Index
+
+	// Orders are the orders of the generated list func, by which its rows
+	// are paginated.
+	Orders []*Order
+
+	// SoftDelete is the field of the soft delete column of the table, or nil
+	// when its rows are deleted from the database.
+	SoftDelete *Field
+
+	// CreatedFields are the fields of the created timestamp columns of the
+	// table, and UpdatedFields the fields of its updated timestamp columns.
+	CreatedFields []*Field
+	UpdatedFields []*Field
+
+	// Version is the field of the version column of the table, or nil when
+	// its rows are not optimistically locked.
+	Version *Field
+}
+
+// Order is a template item for an order of the rows of a type, on its primary
+// key or on a unique index of not null columns, so that the rows can be
+// paginated with a keyset.
+type Order struct {
+	Name   string
+	Fields []*Field
+	Index  *models.Index // nil for the primary key
 }
 
 // ForeignKey is a template item for a foreign relationship on a table.
 type ForeignKey struct {
 	Name       string
+	RevName    string
 	Schema     string
 	Type       *Type
 	Field      *Field
@@ -144,14 +186,45 @@ type ForeignKey struct {
 	Comment    string
 }
 
+// ManyToMany is a template item for a many-to-many relationship of a type
+// with another type, through a join table.
+type ManyToMany struct {
+	Name          string
+	AddName       string
+	RemoveName    string
+	Type          *Type
+	RefType       *Type
+	JoinType      *Type
+	ForeignKey    *ForeignKey // foreign key of the join table to Type
+	RefForeignKey *ForeignKey // foreign key of the join table to RefType
+	Comment       string
+}
+
 // Index is a template item for a index into a table.
 type Index struct {
-	FuncName string
-	Schema   string
-	Type     *Type
-	Fields   []*Field
-	Index    *models.Index
-	Comment  string
+	FuncName       string
+	UpsertFuncName string
+	Schema         string
+	Type           *Type
+	Fields         []*Field
+	Index          *models.Index
+	Comment        string
+}
+
+// BatchLoader is a template item for a func retrieving the rows of a type
+// for many values of one of its fields at once, generated from an index or a
+// foreign key.
+type BatchLoader struct {
+	FuncName   string
+	ParamName  string
+	Schema     string
+	Type       *Type
+	Field      *Field
+	Unique     bool // whether the field values are unique
+	Size       int  // maximum number of values of a query
+	Index      *models.Index
+	ForeignKey *models.ForeignKey
+	Comment    string
 }
 
 // QueryParam is a query parameter for a custom query.
@@ -0,0 +1,200 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_Upserts(t *testing.T) {
+	tests := []struct {
+		desc       string
+		loaderType string
+		manualPk   bool
+		uniqueIsbn bool
+		exp        []string
+		notExp     []string
+	}{
+		{
+			desc:       "postgres",
+			loaderType: "postgres",
+			exp: []string{
+				"func (b *Book) Upsert(db XODB) error {",
+				"func (b *Book) UpsertByTitle(db XODB) error {",
+				"`) ON CONFLICT (title) DO UPDATE SET ` +",
+				"`author_id = EXCLUDED.author_id, title = EXCLUDED.title, isbn = EXCLUDED.isbn` +",
+				"` RETURNING book_id`",
+			},
+			notExp: []string{"UpsertByBookID", "UpsertByIsbn"},
+		},
+		{
+			desc:       "mysql",
+			loaderType: "mysql",
+			exp: []string{
+				"func (b *Book) Upsert(db XODB) error {",
+				"`) ON DUPLICATE KEY UPDATE ` +",
+				"`author_id = VALUES(author_id), title = VALUES(title), isbn = VALUES(isbn)`",
+				"func (b *Book) UpsertByTitle(db XODB) error {",
+				"`book_id = LAST_INSERT_ID(book_id), author_id = VALUES(author_id), title = VALUES(title), isbn = VALUES(isbn)`",
+				"id, err := res.LastInsertId()",
+				"b.BookID = int(id)",
+			},
+			notExp: []string{"UpsertByBookID", "UpsertByIsbn", "pksqlstr"},
+		},
+		{
+			desc:       "mysql manual primary key",
+			loaderType: "mysql",
+			manualPk:   true,
+			exp: []string{
+				"func (b *Book) UpsertByTitle(db XODB) error {",
+				"`WHERE title <=> ?`",
+				".Scan(&b.BookID)",
+			},
+			notExp: []string{"LAST_INSERT_ID"},
+		},
+		{
+			desc:       "mysql manual primary key and unique indexes",
+			loaderType: "mysql",
+			manualPk:   true,
+			uniqueIsbn: true,
+			exp: []string{
+				"func (b *Book) Upsert(db XODB) error {",
+			},
+			notExp: []string{"UpsertByTitle", "UpsertByIsbn"},
+		},
+		{
+			desc:       "mysql unique indexes",
+			loaderType: "mysql",
+			uniqueIsbn: true,
+			exp: []string{
+				"func (b *Book) UpsertByTitle(db XODB) error {",
+				"func (b *Book) UpsertByIsbn(db XODB) error {",
+			},
+		},
+		{
+			desc:       "sqlite3",
+			loaderType: "sqlite3",
+			exp: []string{
+				"`) ON CONFLICT (book_id) DO UPDATE SET ` +",
+				"`author_id = EXCLUDED.author_id, title = EXCLUDED.title, isbn = EXCLUDED.isbn`",
+				"func (b *Book) UpsertByTitle(db XODB) error {",
+				"`) ON CONFLICT (title) DO UPDATE SET ` +",
+			},
+			notExp: []string{"UpsertByBookID", "UpsertByIsbn"},
+		},
+		{
+			desc:       "mssql",
+			loaderType: "mssql",
+			exp: []string{
+				"`MERGE public.books AS t ` +",
+				"`ON t.book_id = s.book_id ` +",
+				"func (b *Book) UpsertByTitle(db XODB) error {",
+				"`ON t.title = s.title ` +",
+				"`OUTPUT inserted.book_id;`",
+			},
+			notExp: []string{"UpsertByBookID", "UpsertByIsbn"},
+		},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		src, err := generate(args, tt.loaderType, []*internal.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "books", ManualPk: tt.manualPk},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: "integer", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "author_id", DataType: "integer", NotNull: true},
+					{FieldOrdinal: 2, ColumnName: "title", DataType: "text", NotNull: true},
+					{FieldOrdinal: 3, ColumnName: "isbn", DataType: "text", NotNull: true},
+				},
+				Indexes: []*internal.SnapshotIndex{
+					{
+						Index:   &models.Index{IndexName: "books_author_idx"},
+						Columns: []*models.IndexColumn{{ColumnName: "author_id"}},
+					},
+					{
+						Index:   &models.Index{IndexName: "books_title_idx", IsUnique: true},
+						Columns: []*models.IndexColumn{{ColumnName: "title"}},
+					},
+					{
+						Index:   &models.Index{IndexName: "books_isbn_idx", IsUnique: true, IsPartial: !tt.uniqueIsbn},
+						Columns: []*models.IndexColumn{{ColumnName: "isbn"}},
+					},
+					{
+						Index:   &models.Index{IndexName: "books_book_id_idx", IsUnique: true},
+						Columns: []*models.IndexColumn{{ColumnName: "book_id"}},
+					},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, tt.notExp)
+	}
+}
+
+func Test_UpsertPrimaryKeyOnly(t *testing.T) {
+	tests := []struct {
+		desc       string
+		loaderType string
+		exp        []string
+		notExp     []string
+	}{
+		{
+			desc:       "postgres",
+			loaderType: "postgres",
+			notExp:     []string{"func (bl *BookLabel) Upsert("},
+		},
+		{
+			desc:       "mysql",
+			loaderType: "mysql",
+			notExp:     []string{"func (bl *BookLabel) Upsert("},
+		},
+		{
+			desc:       "sqlite3",
+			loaderType: "sqlite3",
+			notExp:     []string{"func (bl *BookLabel) Upsert("},
+		},
+		{
+			desc:       "mssql",
+			loaderType: "mssql",
+			exp: []string{
+				"func (bl *BookLabel) Upsert(db XODB) error {",
+				"`WHEN MATCHED THEN UPDATE SET book_id = s.book_id, tag_id = s.tag_id ` +",
+			},
+			notExp: []string{"UPDATE SET  "},
+		},
+		{
+			desc:       "oracle",
+			loaderType: "ora",
+			exp: []string{
+				"func (bl *BookLabel) Upsert(db XODB) error {",
+				"`WHEN NOT MATCHED THEN INSERT (book_id, tag_id) VALUES (s.book_id, s.tag_id)`",
+			},
+			notExp: []string{"WHEN MATCHED"},
+		},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		src, err := generate(args, tt.loaderType, []*internal.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "book_labels", ManualPk: true},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: "integer", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "tag_id", DataType: "integer", NotNull: true, IsPrimaryKey: true},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, tt.notExp)
+	}
+}
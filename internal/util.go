@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"math/rand"
+	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -154,6 +156,23 @@ func (a *ArgType) ParsePrecision(dt string) (string, int, int) {
 	return dt, precision, scale
 }
 
+// TrimSchema removes the schema qualifier from dt when it is qualified by one
+// of the schemas being generated, reporting whether it was removed.
+func (a *ArgType) TrimSchema(dt string) (string, bool) {
+	schemas := a.Schemas
+	if len(schemas) == 0 {
+		schemas = []string{a.Schema}
+	}
+
+	for _, s := range schemas {
+		if strings.HasPrefix(dt, s+".") {
+			return dt[len(s)+1:], true
+		}
+	}
+
+	return dt, false
+}
+
 // IndexChopSuffixRE is the regexp of index name suffixes that will be chopped off.
 var IndexChopSuffixRE = regexp.MustCompile(`(?i)_(ix|idx|index|pkey|ukey|key)$`)
 
@@ -203,6 +222,123 @@ func (a *ArgType) BuildIndexFuncName(ixTpl *Index) {
 
 	// store resulting name back
 	ixTpl.FuncName = funcName + strings.Join(paramNames, "")
+	if ixTpl.Index.IsUnique {
+		ixTpl.UpsertFuncName = "UpsertBy" + strings.Join(paramNames, "")
+	}
+}
+
+// BuildOrders builds the orders of the list func of a type: its primary key,
+// followed by its unique indexes of which all columns are not null, as null
+// values cannot be compared in a keyset.
+func (a *ArgType) BuildOrders(typeTpl *Type) {
+	typeTpl.Orders = nil
+	if len(typeTpl.PrimaryKeyFields) == 0 {
+		return
+	}
+
+	names := []string{}
+	for _, f := range typeTpl.PrimaryKeyFields {
+		names = append(names, f.Name)
+	}
+	typeTpl.Orders = append(typeTpl.Orders, &Order{
+		Name:   "By" + strings.Join(names, ""),
+		Fields: typeTpl.PrimaryKeyFields,
+	})
+
+	seen := map[string]bool{typeTpl.Orders[0].Name: true}
+	for _, ixTpl := range typeTpl.UniqueIndexes {
+		notNull := true
+		names := []string{}
+		for _, f := range ixTpl.Fields {
+			notNull = notNull && f.Col.NotNull
+			names = append(names, f.Name)
+		}
+
+		ixName := fmtIndexName(ixTpl.Index.IndexName, ixTpl.Type.Table.TableName)
+		if a.UseIndexNames && ixName != "" {
+			names = []string{ixName}
+		}
+
+		name := "By" + strings.Join(names, "")
+		if !notNull || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		typeTpl.Orders = append(typeTpl.Orders, &Order{
+			Name:   name,
+			Fields: ixTpl.Fields,
+			Index:  ixTpl.Index,
+		})
+	}
+}
+
+// sameFields determines if a and b are the same fields, in any order.
+func sameFields(a, b []*Field) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	names := map[string]bool{}
+	for _, f := range a {
+		names[f.Name] = true
+	}
+	for _, f := range b {
+		if !names[f.Name] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sameQueryFields determines if the query fields a and b have the same names
+// and types, in the same order.
+func sameQueryFields(a, b []*Field) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Type != b[i].Type {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isIntType returns whether typ is a Go integer type.
+func isIntType(typ string) bool {
+	switch typ {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+
+	return false
+}
+
+// containsString returns whether s is any of the strs.
+func containsString(strs []string, s string) bool {
+	for _, str := range strs {
+		if str == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortedKeys returns the keys of the map m, which must have string keys, in
+// sorted order. Maps are iterated in sorted key order when generating code,
+// so that the generated code is the same on every run.
+func sortedKeys(m interface{}) []string {
+	keys := []string{}
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 // letters for GenRandomID
@@ -251,7 +387,13 @@ type TBuf struct {
 	TemplateType TemplateType
 	Name         string
 	Subname      string
+	Template     string
 	Buf          *bytes.Buffer
+
+	// Filename and Package are the output filename, relative to the output
+	// path, and package name of an extra template, when not the defaults.
+	Filename string
+	Package  string
 }
 
 // TBufSlice is a slice of TBuf compatible with sort.Interface.
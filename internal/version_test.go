@@ -0,0 +1,123 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/turnkey-commerce/gendal/internal"
+	"github.com/turnkey-commerce/gendal/models"
+)
+
+func Test_Version(t *testing.T) {
+	tests := []struct {
+		desc        string
+		loaderType  string
+		versionType string
+		partial     bool
+		column      string
+		exp         []string
+		notExp      []string
+	}{
+		{
+			desc:        "postgres",
+			loaderType:  "postgres",
+			versionType: "integer",
+			column:      "lock_version",
+			exp: []string{
+				`var ErrStaleObject = errors.New("stale object")`,
+				"`title = $1, lock_version = lock_version + 1` +",
+				"` WHERE book_id = $2 AND lock_version = $3`",
+				"b.Title, b.BookID, b.LockVersion)",
+				"return ErrStaleObject",
+				"b.LockVersion++",
+				"`DELETE FROM public.books WHERE book_id = $1 AND lock_version = $2`",
+				"`title = EXCLUDED.title, lock_version = public.books.lock_version + 1` +",
+				"` WHERE public.books.lock_version = EXCLUDED.lock_version` +",
+				"` RETURNING lock_version`",
+				".Scan(&b.LockVersion)",
+				"if err == sql.ErrNoRows {",
+			},
+			notExp: []string{
+				"lock_version = $1",
+				"lock_version = EXCLUDED.lock_version,",
+			},
+		},
+		{
+			desc:        "postgres partial",
+			loaderType:  "postgres",
+			versionType: "integer",
+			partial:     true,
+			column:      "lock_version",
+			exp: []string{
+				"sets = append(sets, `lock_version = lock_version + 1`)",
+				"args = append(args, b.LockVersion)",
+				"conds = append(conds, `lock_version = `+fmt.Sprintf(\"$%d\", len(args)))",
+			},
+			notExp: []string{
+				"reflect.DeepEqual(b.LockVersion",
+			},
+		},
+		{
+			desc:        "mysql",
+			loaderType:  "mysql",
+			versionType: "int",
+			column:      "lock_version",
+			exp: []string{
+				"` WHERE book_id = ? AND lock_version = ?`",
+				"`DELETE FROM public.books WHERE book_id = ? AND lock_version = ?`",
+			},
+			notExp: []string{
+				"Upsert(",
+			},
+		},
+		{
+			desc:        "mssql",
+			loaderType:  "mssql",
+			versionType: "int",
+			column:      "lock_version",
+			exp: []string{
+				"`WHEN MATCHED AND t.lock_version = s.lock_version THEN UPDATE SET title = s.title, lock_version = t.lock_version + 1 ` +",
+				"`OUTPUT inserted.lock_version;`",
+				".Scan(&b.LockVersion)",
+				"if err == sql.ErrNoRows {",
+			},
+		},
+		{
+			desc:        "not an integer",
+			loaderType:  "postgres",
+			versionType: "text",
+			column:      "lock_version",
+			notExp:      []string{"return ErrStaleObject", "lock_version + 1"},
+		},
+		{
+			desc:        "disabled",
+			loaderType:  "postgres",
+			versionType: "integer",
+			exp: []string{
+				"`DELETE FROM public.books WHERE book_id = $1`",
+			},
+			notExp: []string{"ErrStaleObject", "lock_version + 1"},
+		},
+	}
+
+	for i, tt := range tests {
+		args := internal.NewDefaultArgs("")
+		args.PartialUpdates = tt.partial
+		args.VersionColumn = tt.column
+		src, err := generate(args, tt.loaderType, []*internal.SnapshotTable{
+			{
+				Table: &models.Table{TableName: "books"},
+				Columns: []*models.Column{
+					{ColumnName: "book_id", DataType: "integer", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 1, ColumnName: "title", DataType: "text", NotNull: true},
+					{FieldOrdinal: 2, ColumnName: "lock_version", DataType: tt.versionType, NotNull: true},
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("test #%d: %s\n\tunexpected error: %v", i+1, tt.desc, err)
+		}
+
+		checkGenerated(t, i, tt.desc, src, tt.exp, tt.notExp)
+	}
+}
@@ -14,6 +14,8 @@ import (
 func init() {
 	internal.SchemaLoaders["mssql"] = internal.TypeLoader{
 		MaskFunc:       func() string { return "$%d" },
+		ParamLimit:     2098, // 2100, less the statement and params of sp_executesql
+		RowLimit:       1000,
 		ProcessRelkind: MsRelkind,
 		Schema:         MsSchema,
 		ParseTypeFunc:  MsParseType,
@@ -22,7 +24,7 @@ func init() {
 		//ProcList:       models.MsProcs,
 		//ProcParamList:  models.MsProcParams,
 		TableList:       MsTables,
-		ColumnList:      models.MsTableColumns,
+		ColumnList:      MsTableColumns,
 		ForeignKeyList:  models.MsTableForeignKeys,
 		IndexList:       models.MsTableIndexes,
 		IndexColumnList: models.MsIndexColumns,
@@ -168,9 +170,9 @@ func MsParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 		typ = "*time.Duration"
 
 	default:
-		if strings.HasPrefix(dt, args.Schema+".") {
-			// in the same schema, so chop off
-			typ = snaker.SnakeToCamelIdentifier(dt[len(args.Schema)+1:])
+		if name, ok := args.TrimSchema(dt); ok {
+			// in a generated schema, so chop off
+			typ = snaker.SnakeToCamelIdentifier(name)
 			nilVal = typ + "(0)"
 		} else {
 			typ = snaker.SnakeToCamelIdentifier(dt)
@@ -251,3 +253,8 @@ func MsTables(db models.XODB, schema string, relkind string) ([]*models.Table, e
 
 	return tables, nil
 }
+
+// MsTableColumns returns the mssql table column info.
+func MsTableColumns(args *internal.ArgType, schema string, table string) ([]*models.Column, error) {
+	return models.MsTableColumns(args.DB, schema, table)
+}
@@ -23,7 +23,7 @@ func init() {
 		ProcList:        models.MyProcs,
 		ProcParamList:   models.MyProcParams,
 		TableList:       MyTables,
-		ColumnList:      models.MyTableColumns,
+		ColumnList:      MyTableColumns,
 		ForeignKeyList:  models.MyTableForeignKeys,
 		IndexList:       models.MyTableIndexes,
 		IndexColumnList: models.MyIndexColumns,
@@ -198,9 +198,9 @@ switchDT:
 		typ = "string"
 
 	default:
-		if strings.HasPrefix(dt, args.Schema+".") {
-			// in the same schema, so chop off
-			typ = snaker.SnakeToCamelIdentifier(dt[len(args.Schema)+1:])
+		if name, ok := args.TrimSchema(dt); ok {
+			// in a generated schema, so chop off
+			typ = snaker.SnakeToCamelIdentifier(name)
 			nilVal = typ + "(0)"
 		} else {
 			typ = snaker.SnakeToCamelIdentifier(dt)
@@ -301,3 +301,8 @@ func MyQueryColumns(args *internal.ArgType, inspect []string) ([]*models.Column,
 	// load column information
 	return cols, err
 }
+
+// MyTableColumns returns the mysql table column info.
+func MyTableColumns(args *internal.ArgType, schema string, table string) ([]*models.Column, error) {
+	return models.MyTableColumns(args.DB, schema, table)
+}
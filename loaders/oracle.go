@@ -1,5 +1,3 @@
-// +build oracle
-
 package loaders
 
 import (
@@ -8,8 +6,6 @@ import (
 	"regexp"
 	"strings"
 
-	_ "gopkg.in/rana/ora.v4"
-
 	"github.com/turnkey-commerce/gendal/internal"
 	"github.com/turnkey-commerce/gendal/models"
 )
@@ -18,6 +14,7 @@ func init() {
 	internal.SchemaLoaders["ora"] = internal.TypeLoader{
 		ParamN:         func(i int) string { return fmt.Sprintf(":%d", i+1) },
 		MaskFunc:       func() string { return ":%d" },
+		RowLimit:       1000, // the maximum number of values of an IN list
 		ProcessRelkind: OrRelkind,
 		Schema:         OrSchema,
 		ParseTypeFunc:  OrParseType,
@@ -26,8 +23,8 @@ func init() {
 		//ProcList:      models.OrProcs,
 		//ProcParamList: models.OrProcParams,
 		TableList:       models.OrTables,
-		ColumnList:      models.OrTableColumns,
-		ForeignKeyList:  models.OrTableForeignKeys,
+		ColumnList:      OrTableColumns,
+		ForeignKeyList:  OrTableForeignKeys,
 		IndexList:       models.OrTableIndexes,
 		IndexColumnList: models.OrIndexColumns,
 		QueryColumnList: OrQueryColumns,
@@ -191,3 +188,26 @@ func OrQueryColumns(args *internal.ArgType, inspect []string) ([]*models.Column,
 	// load column information
 	return cols, err
 }
+
+// OrTableColumns returns the oracle table column info.
+func OrTableColumns(args *internal.ArgType, schema string, table string) ([]*models.Column, error) {
+	return models.OrTableColumns(args.DB, schema, table)
+}
+
+// OrTableForeignKeys returns the oracle table foreign keys, with the
+// lowercased owners of the keys referencing the same schema set back to
+// the schema as given.
+func OrTableForeignKeys(db models.XODB, schema string, table string) ([]*models.ForeignKey, error) {
+	fkList, err := models.OrTableForeignKeys(db, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fk := range fkList {
+		if strings.EqualFold(fk.RefSchemaName, schema) {
+			fk.RefSchemaName = schema
+		}
+	}
+
+	return fkList, nil
+}
@@ -0,0 +1,9 @@
+// +build oracle
+
+package loaders
+
+import (
+	// the oracle driver requires the Oracle client libraries, so it is only
+	// registered with the oracle build tag
+	_ "gopkg.in/rana/ora.v4"
+)
@@ -77,9 +77,9 @@ func PgParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 	)
 
 	if !ok {
-		if strings.HasPrefix(dt, args.Schema+".") {
-			// in the same schema, so chop off
-			typ = snaker.SnakeToCamelIdentifier(dt[len(args.Schema)+1:])
+		if name, ok := args.TrimSchema(dt); ok {
+			// in a generated schema, so chop off
+			typ = snaker.SnakeToCamelIdentifier(name)
 			nilVal = typ + "(0)"
 		} else {
 			typ = snaker.SnakeToCamelIdentifier(dt)
@@ -291,13 +291,14 @@ func PgIndexColumns(db models.XODB, schema string, table string, index string) (
 	return ret, nil
 }
 
-func PgTableColumns(db models.XODB, schema string, table string) ([]*models.Column, error) {
-	cols, err := models.PgTableColumns(db, schema, table, internal.Args.EnablePostgresOIDs)
+// PgTableColumns returns the postgres table column info.
+func PgTableColumns(args *internal.ArgType, schema string, table string) ([]*models.Column, error) {
+	cols, err := models.PgTableColumns(args.DB, schema, table, args.EnablePostgresOIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	if !internal.Args.EnablePostgresJson {
+	if !args.EnablePostgresJson {
 		return cols, nil
 	}
 
@@ -15,9 +15,12 @@ func init() {
 		ProcessRelkind: SqRelkind,
 		ParamN:         func(int) string { return "?" },
 		MaskFunc:       func() string { return "?" },
+		ParamLimit:     999,
 		ParseTypeFunc:  SqParseType,
 		TableList:      SqTables,
-		ColumnList:     SqTableColumns,
+		ColumnList: func(args *internal.ArgType, schema string, table string) ([]*models.Column, error) {
+			return SqTableColumns(args.DB, schema, table)
+		},
 		ForeignKeyList: func(db models.XODB, schema string, table string) ([]*models.ForeignKey, error) {
 			return models.SqTableForeignKeys(db, table)
 		},
@@ -1,6 +1,5 @@
 package main
 
-//go:generate ./tpl.sh
 //go:generate ./gen.sh models
 
 import (
@@ -9,17 +8,17 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path"
-	"sort"
+	"path/filepath"
 	"strings"
 
 	"github.com/alexflint/go-arg"
 	"github.com/spf13/viper"
 
+	"github.com/turnkey-commerce/gendal/generator"
 	"github.com/turnkey-commerce/gendal/internal"
 	"github.com/turnkey-commerce/gendal/models"
-	"github.com/xo/dburl"
+	"github.com/turnkey-commerce/gendal/templates"
 
 	_ "github.com/turnkey-commerce/gendal/loaders"
 	_ "github.com/xo/xoutil"
@@ -35,19 +34,30 @@ func main() {
 	// support
 	if len(os.Args) == 2 && os.Args[1] == "--has-oracle-support" {
 		var out int
-		if _, ok := internal.SchemaLoaders["ora"]; ok {
-			out = 1
+		for _, d := range sql.Drivers() {
+			if d == "ora" {
+				out = 1
+			}
 		}
 
 		fmt.Fprintf(os.Stdout, "%d", out)
 		return
 	}
 
+	// run the templates command
+	if len(os.Args) > 1 && os.Args[1] == "templates" {
+		err := runCommand()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	var err error
 
 	// get defaults
-	internal.Args = internal.NewDefaultArgs(version)
-	args := internal.Args
+	args := internal.NewDefaultArgs(version)
 
 	// first look for settings in a gendal.toml config file
 	viper.SetConfigName("gendal")
@@ -82,54 +92,68 @@ func main() {
 		os.Exit(1)
 	}
 
-	// open database
-	err = openDB(args)
+	err = run(args)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "error: %v\n", err)
 		os.Exit(1)
 	}
-	defer args.DB.Close()
+}
 
-	// load schema name
-	if args.Schema == "" {
-		args.Schema, err = args.Loader.SchemaName(args)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "error: %v\n", err)
-			os.Exit(1)
-		}
+// run generates the code, checks the generated code or writes a snapshot, as
+// determined by args.
+func run(args *internal.ArgType) error {
+	g, err := generator.New(args, generator.FileSink{})
+	if err != nil {
+		return err
 	}
+	defer g.Close()
 
-	// load defs into type map
-	if args.QueryMode {
-		err = args.Loader.ParseQuery(args)
+	// open database or snapshot
+	if args.FromSnapshot != "" {
+		var s *generator.Snapshot
+		s, err = generator.ReadSnapshot(args.FromSnapshot)
+		if err != nil {
+			return err
+		}
+		err = g.SetSnapshot(s)
 	} else {
-		err = args.Loader.LoadSchema(args)
+		err = g.Open(args.DSN)
 	}
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "error: %v\n", err)
-		os.Exit(1)
+		return err
 	}
 
-	// add xo
-	err = args.ExecuteTemplate(internal.XOTemplate, "xo_db", "", args)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "error: %v\n", err)
-		os.Exit(1)
+	// write snapshot instead of generating code
+	if args.Snapshot != "" {
+		s, err := g.Snapshot()
+		if err != nil {
+			return err
+		}
+		return generator.WriteSnapshot(args.Snapshot, s)
 	}
 
-	// output
-	err = writeTypes(args)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "error: %v\n", err)
-		os.Exit(1)
+	// check output against existing files
+	if args.Check {
+		n, err := g.Check(os.Stdout)
+		if err != nil {
+			return err
+		}
+		if n != 0 {
+			return fmt.Errorf("%d generated file(s) out of date", n)
+		}
+		return nil
 	}
+
+	// output
+	return g.Generate()
 }
 
-// processArgs processs cli args.
+// processArgs processs cli args, determining the output path and reading the
+// query from stdin. The remaining args are checked by the generator.
 func processArgs(args *internal.ArgType) error {
 	var err error
 
-	if len(args.DSN) == 0 {
+	if len(args.DSN) == 0 && args.FromSnapshot == "" {
 		return errors.New("Database DSN must be provided")
 	}
 
@@ -171,6 +195,14 @@ func processArgs(args *internal.ArgType) error {
 		}
 	}
 
+	// snapshots are of the whole schema
+	if args.Snapshot != "" && args.FromSnapshot != "" {
+		return errors.New("a snapshot cannot be written from a snapshot")
+	}
+	if args.Snapshot != "" && (args.QueryMode || args.Query != "" || args.QueryDir != "") {
+		return errors.New("a snapshot cannot be written in query mode")
+	}
+
 	// check user template path
 	if args.TemplatePath != "" {
 		fi, err := os.Stat(args.TemplatePath)
@@ -181,23 +213,33 @@ func processArgs(args *internal.ArgType) error {
 		}
 	}
 
+	// check shared template partials path
+	if args.PartialsPath != "" {
+		fi, err := os.Stat(args.PartialsPath)
+		if err == nil && !fi.IsDir() {
+			return errors.New("partials path is not directory")
+		} else if err != nil {
+			return errors.New("partials path must exist")
+		}
+	}
+
 	// fix path
 	if args.Path == "." {
 		args.Path = cwd
 	}
 
-	// determine package name
-	if args.Package == "" {
-		args.Package = path.Base(args.Path)
-	}
-
-	// determine filename if not previously set
-	if args.Filename == "" {
-		args.Filename = args.Package + args.Suffix
+	// check query dir
+	if args.QueryDir != "" {
+		fi, err := os.Stat(args.QueryDir)
+		if err == nil && !fi.IsDir() {
+			return errors.New("query path is not directory")
+		} else if err != nil {
+			return errors.New("query path must exist")
+		}
 	}
 
 	// if query mode toggled, but no query, read Stdin.
-	if args.QueryMode && args.Query == "" {
+	if args.QueryMode && args.QueryDir == "" && args.Query == "" {
 		buf, err := ioutil.ReadAll(os.Stdin)
 		if err != nil {
 			return err
@@ -205,28 +247,6 @@ func processArgs(args *internal.ArgType) error {
 		args.Query = string(buf)
 	}
 
-	// query mode parsing
-	if args.Query != "" {
-		args.QueryMode = true
-	}
-
-	// check that query type was specified
-	if args.QueryMode && args.QueryType == "" {
-		return errors.New("query type must be supplied for query parsing mode")
-	}
-
-	// query trim
-	if args.QueryMode && args.QueryTrim {
-		args.Query = strings.TrimSpace(args.Query)
-	}
-
-	// escape all
-	if args.EscapeAll {
-		args.EscapeSchemaName = true
-		args.EscapeTableNames = true
-		args.EscapeColumnNames = true
-	}
-
 	// if verbose
 	if args.Verbose {
 		models.XOLog = func(s string, p ...interface{}) {
@@ -234,170 +254,89 @@ func processArgs(args *internal.ArgType) error {
 		}
 	}
 
-	err = args.PopulateInternalTypeOverrides()
-	if err != nil {
-		return err
-	}
-
 	return nil
 }
 
-// openDB attempts to open a database connection.
-func openDB(args *internal.ArgType) error {
-	var err error
+// commandArgs are the commands of gendal other than generating code.
+type commandArgs struct {
+	Templates *templatesCmd `arg:"subcommand:templates" help:"work with the built in templates"`
+}
 
-	// parse dsn
-	u, err := dburl.Parse(args.DSN)
-	if err != nil {
-		return err
-	}
+// templatesCmd is the 'gendal templates' command.
+type templatesCmd struct {
+	Export *templatesExportCmd `arg:"subcommand:export" help:"write the built in templates to a directory"`
+}
 
-	// save driver type
-	args.LoaderType = u.Driver
+// templatesExportCmd is the 'gendal templates export' command.
+type templatesExportCmd struct {
+	Dir   string `arg:"positional,required,help:directory to write the templates to"`
+	DB    string `arg:"--db,help:only write the templates of the database [ie: postgres]"`
+	Force bool   `arg:"--force,help:overwrite existing templates"`
+}
 
-	// grab loader
-	var ok bool
-	args.Loader, ok = internal.SchemaLoaders[u.Driver]
-	if !ok {
-		return errors.New("unsupported database type")
-	}
+// runCommand runs the gendal command other than generating code.
+func runCommand() error {
+	var cmd commandArgs
+	p := arg.MustParse(&cmd)
 
-	// open database connection
-	args.DB, err = sql.Open(u.Driver, u.DSN)
-	if err != nil {
-		return err
+	switch {
+	case cmd.Templates != nil && cmd.Templates.Export != nil:
+		return exportTemplates(cmd.Templates.Export)
 	}
 
-	return nil
+	p.WriteUsage(os.Stderr)
+	return errors.New("a command must be provided")
 }
 
-// files is a map of filenames to open file handles.
-var files = map[string]*os.File{}
-
-// getFile builds the filepath from the TBuf information, and retrieves the
-// file from files. If the built filename is not already defined, then it calls
-// the os.OpenFile with the correct parameters depending on the state of args.
-func getFile(args *internal.ArgType, t *internal.TBuf) (*os.File, error) {
-	var f *os.File
+// exportTemplates writes the built in templates to the export directory, with
+// the symlinked templates written as regular files.
+func exportTemplates(cmd *templatesExportCmd) error {
 	var err error
 
-	// determine filename
-	var filename = strings.ToLower(t.Name) + args.Suffix
-	if args.SingleFile {
-		filename = args.Filename
-	}
-	filename = path.Join(args.Path, filename)
-
-	// lookup file
-	f, ok := files[filename]
-	if ok {
-		return f, nil
+	// check database
+	if cmd.DB != "" {
+		found := false
+		for _, db := range templates.DatabaseNames() {
+			found = found || db == cmd.DB
+		}
+		if !found {
+			return fmt.Errorf("no templates for database '%s' [values: <%s>]", cmd.DB, strings.Join(templates.DatabaseNames(), "|"))
+		}
 	}
 
-	// default open mode
-	mode := os.O_RDWR | os.O_CREATE | os.O_TRUNC
-
-	// stat file to determine if file already exists
-	fi, err := os.Stat(filename)
-	if err == nil && fi.IsDir() {
-		return nil, errors.New("filename cannot be directory")
-	} else if _, ok = err.(*os.PathError); !ok && args.Append && t.TemplateType != internal.XOTemplate {
-		// file exists so append if append is set and not XO type
-		mode = os.O_APPEND | os.O_WRONLY
+	// the xo templates are used with every database
+	names := []string{}
+	for _, name := range templates.AssetNames() {
+		if cmd.DB == "" || strings.HasPrefix(name, cmd.DB+".") || strings.HasPrefix(name, "xo_") {
+			names = append(names, name)
+		}
 	}
 
-	// skip
-	if t.TemplateType == internal.XOTemplate && fi != nil {
-		return nil, nil
+	// check for existing templates before writing any
+	if !cmd.Force {
+		for _, name := range names {
+			if _, err = os.Stat(filepath.Join(cmd.Dir, name)); err == nil {
+				return fmt.Errorf("template %s already exists, use --force to overwrite it", filepath.Join(cmd.Dir, name))
+			}
+		}
 	}
 
-	// open file
-	f, err = os.OpenFile(filename, mode, 0666)
+	err = os.MkdirAll(cmd.Dir, 0777)
 	if err != nil {
-		return nil, err
-	}
-
-	// file didn't originally exist, so add package header
-	if fi == nil || !args.Append {
-		// add build tags
-		if args.Tags != "" {
-			f.WriteString(`// +build ` + args.Tags + "\n\n")
-		}
-
-		// execute
-		err = args.TemplateSet().Execute(f, "xo_package.go.tpl", args)
-		if err != nil {
-			return nil, err
-		}
+		return err
 	}
 
-	// store file
-	files[filename] = f
-
-	return f, nil
-}
-
-// writeTypes writes the generated definitions.
-func writeTypes(args *internal.ArgType) error {
-	var err error
-
-	out := internal.TBufSlice(args.Generated)
-
-	// sort segments
-	sort.Sort(out)
-
-	// loop, writing in order
-	for _, t := range out {
-		var f *os.File
-
-		// skip when in append and type is XO
-		if args.Append && t.TemplateType == internal.XOTemplate {
-			continue
-		}
-
-		// check if generated template is only whitespace/empty
-		bufStr := strings.TrimSpace(t.Buf.String())
-		if len(bufStr) == 0 {
-			continue
-		}
-
-		// get file and filename
-		f, err = getFile(args, &t)
+	for _, name := range names {
+		buf, err := templates.Asset(name)
 		if err != nil {
 			return err
 		}
 
-		// should only be nil when type == xo
-		if f == nil {
-			continue
-		}
-
-		// write segment
-		if !args.Append || (t.TemplateType != internal.TypeTemplate && t.TemplateType != internal.QueryTypeTemplate) {
-			_, err = t.Buf.WriteTo(f)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	// build goimports parameters, closing files
-	params := []string{"-w"}
-	for k, f := range files {
-		params = append(params, k)
-
-		// close
-		err = f.Close()
+		err = ioutil.WriteFile(filepath.Join(cmd.Dir, name), buf, 0666)
 		if err != nil {
 			return err
 		}
 	}
 
-	// process written files with goimports
-	output, err := exec.Command("goimports", params...).CombinedOutput()
-	if err != nil {
-		return errors.New(string(output))
-	}
-
 	return nil
 }
@@ -8,6 +8,7 @@ type ForeignKey struct {
 	ForeignKeyName string // foreign_key_name
 	ColumnName     string // column_name
 	RefIndexName   string // ref_index_name
+	RefSchemaName  string // ref_schema_name
 	RefTableName   string // ref_table_name
 	RefColumnName  string // ref_column_name
 	KeyID          int    // key_id
@@ -26,6 +27,7 @@ func PgTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		`r.conname, ` + // ::varchar AS foreign_key_name
 		`b.attname, ` + // ::varchar AS column_name
 		`i.relname, ` + // ::varchar AS ref_index_name
+		`m.nspname, ` + // ::varchar AS ref_schema_name
 		`c.relname, ` + // ::varchar AS ref_table_name
 		`d.attname, ` + // ::varchar AS ref_column_name
 		`0, ` + // ::integer AS key_id
@@ -38,6 +40,7 @@ func PgTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		`JOIN ONLY pg_attribute b ON b.attisdropped = false AND b.attnum = ANY(r.conkey) AND b.attrelid = r.conrelid ` +
 		`JOIN ONLY pg_class i on i.oid = r.conindid ` +
 		`JOIN ONLY pg_class c on c.oid = r.confrelid ` +
+		`JOIN ONLY pg_namespace m ON m.oid = c.relnamespace ` +
 		`JOIN ONLY pg_attribute d ON d.attisdropped = false AND d.attnum = ANY(r.confkey) AND d.attrelid = r.confrelid ` +
 		`JOIN ONLY pg_namespace n ON n.oid = r.connamespace ` +
 		`WHERE r.contype = 'f' AND n.nspname = $1 AND a.relname = $2 ` +
@@ -57,7 +60,7 @@ func PgTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		fk := ForeignKey{}
 
 		// scan
-		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefIndexName, &fk.RefTableName, &fk.RefColumnName, &fk.KeyID, &fk.SeqNo, &fk.OnUpdate, &fk.OnDelete, &fk.Match)
+		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefIndexName, &fk.RefSchemaName, &fk.RefTableName, &fk.RefColumnName, &fk.KeyID, &fk.SeqNo, &fk.OnUpdate, &fk.OnDelete, &fk.Match)
 		if err != nil {
 			return nil, err
 		}
@@ -76,6 +79,7 @@ func MyTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 	const sqlstr = `SELECT ` +
 		`constraint_name AS foreign_key_name, ` +
 		`column_name AS column_name, ` +
+		`referenced_table_schema AS ref_schema_name, ` +
 		`referenced_table_name AS ref_table_name, ` +
 		`referenced_column_name AS ref_column_name ` +
 		`FROM information_schema.key_column_usage ` +
@@ -95,7 +99,7 @@ func MyTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		fk := ForeignKey{}
 
 		// scan
-		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefTableName, &fk.RefColumnName)
+		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefSchemaName, &fk.RefTableName, &fk.RefColumnName)
 		if err != nil {
 			return nil, err
 		}
@@ -146,6 +150,7 @@ func MsTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 	const sqlstr = `SELECT ` +
 		`f.name AS foreign_key_name, ` +
 		`c.name AS column_name, ` +
+		`SCHEMA_NAME(o.uid) AS ref_schema_name, ` +
 		`o.name AS ref_table_name, ` +
 		`x.name AS ref_column_name ` +
 		`FROM sysobjects f ` +
@@ -170,7 +175,7 @@ func MsTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		fk := ForeignKey{}
 
 		// scan
-		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefTableName, &fk.RefColumnName)
+		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefSchemaName, &fk.RefTableName, &fk.RefColumnName)
 		if err != nil {
 			return nil, err
 		}
@@ -190,6 +195,7 @@ func OrTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		`LOWER(a.constraint_name) AS foreign_key_name, ` +
 		`LOWER(a.column_name) AS column_name, ` +
 		`LOWER(r.constraint_name) AS ref_index_name, ` +
+		`LOWER(r.owner) AS ref_schema_name, ` +
 		`LOWER(r.table_name) AS ref_table_name ` +
 		`FROM all_cons_columns a ` +
 		`JOIN all_constraints c ON a.owner = c.owner AND a.constraint_name = c.constraint_name ` +
@@ -210,7 +216,7 @@ func OrTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		fk := ForeignKey{}
 
 		// scan
-		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefIndexName, &fk.RefTableName)
+		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefIndexName, &fk.RefSchemaName, &fk.RefTableName)
 		if err != nil {
 			return nil, err
 		}
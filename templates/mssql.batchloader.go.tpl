@@ -0,0 +1 @@
+mysql.batchloader.go.tpl
\ No newline at end of file
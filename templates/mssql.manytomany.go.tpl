@@ -0,0 +1 @@
+postgres.manytomany.go.tpl
\ No newline at end of file
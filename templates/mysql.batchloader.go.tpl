@@ -0,0 +1,97 @@
+{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "ctx" "q" "res" "XOLog" "i" "v" "k" "keys" "seen" "batch" "args" "params" .ParamName) -}}
+{{- $table := (schema .Schema .Type.Table.TableName) -}}
+{{- range $i, $all := (includingdeleted .Type) -}}
+{{- if $i }}
+
+{{ end -}}
+// {{ $.FuncName }}{{ if $all }}IncludingDeleted{{ end }} retrieves the rows from '{{ $table }}' as {{ pluralize $.Type.Name }}, for each of the {{ $.ParamName }},
+// using queries of at most {{ $.Size }} values each.
+//
+// The {{ pluralize $.Type.Name }} are keyed by their {{ $.Field.Name }}.
+{{- if $all }}
+//
+// The soft deleted {{ pluralize $.Type.Name }} are included.
+{{- else if $.Type.SoftDelete }}
+//
+// The soft deleted {{ pluralize $.Type.Name }} are skipped.
+{{- end }}
+{{- if $.Index }}
+//
+// Generated from index '{{ $.Index.IndexName }}'.
+{{- else }}
+//
+// Generated from foreign key '{{ $.ForeignKey.ForeignKeyName }}'.
+{{- end }}
+func {{ $.FuncName }}{{ if $all }}IncludingDeleted{{ end }}({{ ctxparam }}db XODB, {{ $.ParamName }} []{{ retype $.Field.Type }}) (map[{{ retype $.Field.Type }}]{{ if not $.Unique }}[]{{ end }}*{{ $.Type.Name }}, error) {
+	// remove duplicates, so that the rows are retrieved once
+	keys := make([]{{ retype $.Field.Type }}, 0, len({{ $.ParamName }}))
+	seen := map[{{ retype $.Field.Type }}]bool{}
+	for _, k := range {{ $.ParamName }} {
+		if !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+
+	res := map[{{ retype $.Field.Type }}]{{ if not $.Unique }}[]{{ end }}*{{ $.Type.Name }}{}
+	for len(keys) != 0 {
+		batch := keys
+		if len(batch) > {{ $.Size }} {
+			batch = batch[:{{ $.Size }}]
+		}
+		keys = keys[len(batch):]
+
+		// build the params of the query
+		params := make([]string, len(batch))
+		args := make([]interface{}, len(batch))
+		for i, v := range batch {
+			params[i] = {{ paramexpr "i+1" }}
+			args[i] = v
+		}
+
+		// sql query
+		sqlstr := `SELECT ` +
+			`{{ colnames $.Type.Fields }}{{ if and $all $.Type.PrimaryKey }}, {{ softdeletedcol $.Type }}{{ end }} ` +
+			`FROM {{ $table }} ` +
+			`WHERE {{ colname $.Field.Col }} IN (` + strings.Join(params, ", ") + `){{ if and $.Type.SoftDelete (not $all) }} AND {{ softdeletecond $.Type "" }}{{ end }}`
+
+		// run query
+		XOLog(sqlstr, args...)
+		q, err := db.{{ ctxmethod "Query" }}({{ ctxarg }}sqlstr, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		// load results
+		for q.Next() {
+			{{ $short }} := {{ $.Type.Name }}{
+			{{- if $.Type.PrimaryKey }}
+				_exists: true,
+			{{ end -}}
+			}
+
+			// scan
+			err = q.Scan({{ fieldnames $.Type.Fields (print "&" $short) }}{{ if and $all $.Type.PrimaryKey }}, &{{ $short }}._deleted{{ end }})
+			if err != nil {
+				q.Close()
+				return nil, err
+			}
+{{- if partialupdates $.Type }}
+			{{ $short }}.snapshot()
+{{- end }}
+{{ if $.Unique }}
+			res[{{ $short }}.{{ $.Field.Name }}] = &{{ $short }}
+{{- else }}
+			res[{{ $short }}.{{ $.Field.Name }}] = append(res[{{ $short }}.{{ $.Field.Name }}], &{{ $short }})
+{{- end }}
+		}
+		err = q.Err()
+		q.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+{{- end }}
@@ -1,4 +1,5 @@
-{{- $short := (shortname .Name "err" "res" "sqlstr" "db" "XOLog") -}}
+{{- $short := (shortname .Name "err" "res" "sqlstr" "db" "ctx" "XOLog") -}}
+{{- block "struct" . -}}
 {{- $table := (schema .Schema .Table.TableName) -}}
 {{- if .Comment -}}
 // {{ .Comment }}
@@ -7,17 +8,21 @@
 {{- end }}
 type {{ .Name }} struct {
 {{- range .Fields }}
-	{{ .Name }} {{ retype .Type }} `json:"{{ .Col.ColumnName }}"` // {{ .Col.ColumnName }}
+	{{ .Name }} {{ retype .Type }} {{ fieldtag $ . }} // {{ .Col.ColumnName }}
 {{- end }}
 {{- if .PrimaryKey }}
 
 	// xo fields
 	_exists, _deleted bool
+{{- if partialupdates . }}
+	_snapshot *{{ .Name }}
+{{- end }}
 {{ end }}
 {{- range .ForeignKeys }}
 	{{ foreignFieldName .Field.Name }}FK *{{ .RefType.Name }} `json:"{{ foreignDBName .Field.Col.ColumnName }}" db:"{{ foreignDBName .Field.Col.ColumnName }}"`
 {{- end }}
 }
+{{- end }}
 
 {{- if .Sqlx }}
 	// /////////////////////////////////////////////////////////////////////////
@@ -50,24 +55,49 @@ type {{ .Name }} struct {
 {{- end }}
 
 {{ if .PrimaryKey }}
-// Exists determines if the {{ .Name }} exists in the database.
+// Exists determines if the {{ .Name }} exists in the database{{ if .SoftDelete }}, and is not soft deleted{{ end }}.
 func ({{ $short }} *{{ .Name }}) Exists() bool {
-	return {{ $short }}._exists
+	return {{ $short }}._exists{{ if .SoftDelete }} && !{{ $short }}._deleted{{ end }}
 }
 
 // Deleted provides information if the {{ .Name }} has been deleted from the database.
 func ({{ $short }} *{{ .Name }}) Deleted() bool {
 	return {{ $short }}._deleted
 }
+{{- if partialupdates . }}
+{{- $short := (shortname .Name "err" "res" "sqlstr" "db" "ctx" "XOLog" "snap") }}
 
+// snapshot saves the values of the fields of the {{ .Name }}, so that Update only
+// updates the fields changed since.
+func ({{ $short }} *{{ .Name }}) snapshot() {
+	snap := *{{ $short }}
+	snap._snapshot = nil
+	{{ $short }}._snapshot = &snap
+}
+{{- end }}
+
+{{ block "insert" . -}}
+{{- $short := (shortname .Name "err" "res" "sqlstr" "db" "ctx" "XOLog" "now") -}}
+{{- $table := (schema .Schema .Table.TableName) -}}
 // Insert inserts the {{ .Name }} to the database.
-func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
+func ({{ $short }} *{{ .Name }}) Insert({{ ctxparam }}db XODB) error {
 	var err error
 
 	// if already exist, bail
 	if {{ $short }}._exists {
 		return errors.New("insert failed: already exists")
 	}
+{{- if or .CreatedFields .UpdatedFields }}
+
+	// set timestamps
+	now := XONow()
+{{- range .CreatedFields }}
+	{{ $short }}.{{ .Name }} = {{ timeval . "now" }}
+{{- end }}
+{{- range .UpdatedFields }}
+	{{ $short }}.{{ .Name }} = {{ timeval . "now" }}
+{{- end }}
+{{- end }}
 
 
 {{ if .Table.ManualPk  }}
@@ -80,13 +110,16 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 	// run query
 	XOLog(sqlstr, {{ fieldnames .Fields $short }})
-	_, err = db.Exec(sqlstr, {{ fieldnames .Fields $short }})
+	_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .Fields $short }})
 	if err != nil {
 		return err
 	}
 
 	// set existence
 	{{ $short }}._exists = true
+{{- if partialupdates $ }}
+	{{ $short }}.snapshot()
+{{- end }}
 {{ else }}
 	// sql insert query, primary key provided by autoincrement
 	const sqlstr = `INSERT INTO {{ $table }} (` +
@@ -97,7 +130,7 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 	// run query
 	XOLog(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }})
-	res, err := db.Exec(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }})
+	res, err := db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }})
 	if err != nil {
 		return err
 	}
@@ -111,14 +144,118 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 	// set primary key and existence
 	{{ $short }}.{{ .PrimaryKey.Name }} = {{ .PrimaryKey.Type }}(id)
 	{{ $short }}._exists = true
+{{- if partialupdates $ }}
+	{{ $short }}.snapshot()
+{{- end }}
 {{ end }}
 
 	return nil
 }
+{{- end }}
+
+{{ block "insertbatch" . -}}
+{{- $short := (shortname .Name "err" "res" "sqlstr" "db" "ctx" "XOLog" "q" "i" "j" "id" "args" "vals" "params" "start" "batch" "now") -}}
+{{- $table := (schema .Schema .Table.TableName) -}}
+{{- $name := (pluralize .Name) -}}
+{{- $ignore := .PrimaryKey.Name -}}
+{{- if .Table.ManualPk }}{{ $ignore = "" }}{{ end -}}
+{{- $rows := (batchrows .Fields $ignore) -}}
+// Insert{{ $name }} inserts the {{ $name }} to the database, using multi-row
+// inserts of at most {{ $rows }} rows each.
+{{- if not .Table.ManualPk }}
+//
+// The primary keys provided by autoincrement are not retrieved, so the inserted
+// {{ $name }} are not marked as existing.
+{{- end }}
+func Insert{{ $name }}({{ ctxparam }}db XODB, {{ $short }}s []*{{ .Name }}) error {
+	// if any already exist, bail
+	for _, {{ $short }} := range {{ $short }}s {
+		if {{ $short }}._exists {
+			return errors.New("insert failed: already exists")
+		}
+	}
+{{- if or .CreatedFields .UpdatedFields }}
+
+	// set timestamps
+	now := XONow()
+{{- end }}
+
+	for len({{ $short }}s) != 0 {
+		batch := {{ $short }}s
+		if len(batch) > {{ $rows }} {
+			batch = batch[:{{ $rows }}]
+		}
+		{{ $short }}s = {{ $short }}s[len(batch):]
+
+		// build the values of the rows
+		var args []interface{}
+		vals := make([]string, len(batch))
+		for i, {{ $short }} := range batch {
+{{- range $.CreatedFields }}
+			{{ $short }}.{{ .Name }} = {{ timeval . "now" }}
+{{- end }}
+{{- range $.UpdatedFields }}
+			{{ $short }}.{{ .Name }} = {{ timeval . "now" }}
+{{- end }}
+			start := len(args)
+			args = append(args, {{ fieldnames .Fields $short $ignore }})
+
+			params := make([]string, 0, len(args)-start)
+			for j := start; j < len(args); j++ {
+				params = append(params, {{ paramexpr "j+1" }})
+			}
+			vals[i] = `(` + strings.Join(params, ", ") + `)`
+		}
+{{ if .Table.ManualPk }}
+		// sql insert query, primary keys must be provided
+		sqlstr := `INSERT INTO {{ $table }} (` +
+			`{{ colnames .Fields }}` +
+			`) VALUES ` + strings.Join(vals, ", ")
+
+		// run query
+		XOLog(sqlstr, args...)
+		_, err := db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, args...)
+		if err != nil {
+			return err
+		}
+
+		// set existence
+		for _, {{ $short }} := range batch {
+			{{ $short }}._exists = true
+{{- if partialupdates $ }}
+			{{ $short }}.snapshot()
+{{- end }}
+		}
+{{- else }}
+		// sql insert query, primary keys provided by autoincrement
+		sqlstr := `INSERT INTO {{ $table }} (` +
+			`{{ colnames .Fields .PrimaryKey.Name }}` +
+			`) VALUES ` + strings.Join(vals, ", ")
+
+		// run query
+		XOLog(sqlstr, args...)
+		_, err := db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, args...)
+		if err != nil {
+			return err
+		}
+{{- end }}
+	}
+
+	return nil
+}
+{{- end }}
 
 {{ if ne (fieldnamesmulti .Fields $short .PrimaryKeyFields) "" }}
+	{{ block "update" . -}}
+	{{- $short := (shortname .Name "err" "res" "sqlstr" "db" "ctx" "XOLog" "sets" "args" "conds" "now" "affected") -}}
+	{{- $table := (schema .Schema .Table.TableName) -}}
+	{{ if partialupdates . -}}
+	// Update updates the {{ .Name }} in the database, only setting the fields
+	// changed since it was loaded, inserted or updated.
+	{{- else -}}
 	// Update updates the {{ .Name }} in the database.
-	func ({{ $short }} *{{ .Name }}) Update(db XODB) error {
+	{{- end }}
+	func ({{ $short }} *{{ .Name }}) Update({{ ctxparam }}db XODB) error {
 		var err error
 
 		// if doesn't exist, bail
@@ -130,6 +267,119 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 		if {{ $short }}._deleted {
 			return errors.New("update failed: marked for deletion")
 		}
+{{ if partialupdates . }}
+		// build the sets of the fields changed since the {{ .Name }} was loaded,
+		// inserted or updated, or of all of the fields when unknown
+		var sets []string
+		var args []interface{}
+	{{- range .Fields }}
+	{{- if not (or (hasfield (lockfields $) .Name) (hasfield $.UpdatedFields .Name)) }}
+		if {{ $short }}._snapshot == nil || !reflect.DeepEqual({{ $short }}.{{ .Name }}, {{ $short }}._snapshot.{{ .Name }}) {
+			args = append(args, {{ $short }}.{{ .Name }})
+			sets = append(sets, `{{ colname .Col }} = `+{{ paramexpr "len(args)" }})
+		}
+	{{- end }}
+	{{- end }}
+
+		// if unchanged, bail
+		if len(sets) == 0 {
+			return nil
+		}
+{{- if .UpdatedFields }}
+
+		// set timestamps
+		now := XONow()
+{{- range .UpdatedFields }}
+		{{ $short }}.{{ .Name }} = {{ timeval . "now" }}
+		args = append(args, {{ $short }}.{{ .Name }})
+		sets = append(sets, `{{ colname .Col }} = `+{{ paramexpr "len(args)" }})
+{{- end }}
+{{- end }}
+
+{{- if .Version }}
+
+		// increment version
+		sets = append(sets, `{{ versionset . }}`)
+{{- end }}
+
+		// build the conditions of the primary key{{ if .Version }} and version{{ end }}
+		var conds []string
+	{{- range (lockfields .) }}
+		args = append(args, {{ $short }}.{{ .Name }})
+		conds = append(conds, `{{ colname .Col }} = `+{{ paramexpr "len(args)" }})
+	{{- end }}
+
+		// sql query
+		sqlstr := `UPDATE {{ $table }} SET ` + strings.Join(sets, `, `) +
+			` WHERE ` + strings.Join(conds, ` AND `)
+
+		// run query
+		XOLog(sqlstr, args...)
+{{- if .Version }}
+		res, err := db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, args...)
+		if err != nil {
+			return err
+		}
+
+		// if changed since loaded, bail
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return ErrStaleObject
+		}
+
+		// increment version
+		{{ $short }}.{{ .Version.Name }}++
+{{- else }}
+		_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, args...)
+		if err != nil {
+			return err
+		}
+{{- end }}
+
+		// snapshot the updated fields
+		{{ $short }}.snapshot()
+
+		return nil
+{{- else }}
+{{- if .UpdatedFields }}
+
+		// set timestamps
+		now := XONow()
+{{- range .UpdatedFields }}
+		{{ $short }}.{{ .Name }} = {{ timeval . "now" }}
+{{- end }}
+{{- end }}
+{{- if .Version }}
+
+		// sql query, failing when the version changed since loaded
+		const sqlstr = `UPDATE {{ $table }} SET ` +
+			`{{ with colnamesquerymulti .Fields ", " 0 (lockfields .) }}{{ . }}, {{ end }}{{ versionset . }}` +
+			` WHERE {{ colnamesquerymulti (lockfields .) " AND " (getstartcount .Fields (lockfields .)) nil }}`
+
+		// run query
+		XOLog(sqlstr, {{ with fieldnamesmulti .Fields $short (lockfields .) }}{{ . }}, {{ end }}{{ fieldnames (lockfields .) $short }})
+		res, err := db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ with fieldnamesmulti .Fields $short (lockfields .) }}{{ . }}, {{ end }}{{ fieldnames (lockfields .) $short }})
+		if err != nil {
+			return err
+		}
+
+		// if changed since loaded, bail
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return ErrStaleObject
+		}
+
+		// increment version
+		{{ $short }}.{{ .Version.Name }}++
+
+		return nil
+{{- else }}
 
 		{{ if gt ( len .PrimaryKeyFields ) 1 }}
 			// sql query with composite primary key
@@ -139,7 +389,7 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 			// run query
 			XOLog(sqlstr, {{ fieldnamesmulti .Fields $short .PrimaryKeyFields }}, {{ fieldnames .PrimaryKeyFields $short}})
-			_, err = db.Exec(sqlstr, {{ fieldnamesmulti .Fields $short .PrimaryKeyFields }}, {{ fieldnames .PrimaryKeyFields $short}})
+			_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnamesmulti .Fields $short .PrimaryKeyFields }}, {{ fieldnames .PrimaryKeyFields $short}})
 			return err
 		{{- else }}
 			// sql query
@@ -149,25 +399,346 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 			// run query
 			XOLog(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
-			_, err = db.Exec(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
+			_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
 			return err
 		{{- end }}
+{{- end }}
+{{- end }}
 	}
+	{{- end }}
 
 	// Save saves the {{ .Name }} to the database.
-	func ({{ $short }} *{{ .Name }}) Save(db XODB) error {
+	func ({{ $short }} *{{ .Name }}) Save({{ ctxparam }}db XODB) error {
 		if {{ $short }}.Exists() {
-			return {{ $short }}.Update(db)
+			return {{ $short }}.Update({{ ctxarg }}db)
+		}
+
+		return {{ $short }}.Insert({{ ctxarg }}db)
+	}
+
+	{{ block "upsert" . -}}
+	{{- $short := (shortname .Name "err" "res" "sqlstr" "pksqlstr" "db" "ctx" "XOLog" "now" "id") -}}
+	{{- $table := (schema .Schema .Table.TableName) -}}
+	{{- if .Version }}
+	// Upsert funcs omitted as ON DUPLICATE KEY UPDATE cannot check the version
+	// of the existing row
+	{{- else -}}
+	// Upsert performs an upsert for {{ .Name }}.
+	//
+	// NOTE: MySQL updates the existing row on a conflict of any unique index
+	func ({{ $short }} *{{ .Name }}) Upsert({{ ctxparam }}db XODB) error {
+		var err error
+
+		// if already exist, bail
+		if {{ $short }}._exists {
+			return errors.New("insert failed: already exists")
+		}
+{{- if or .CreatedFields .UpdatedFields }}
+
+		// set timestamps
+		now := XONow()
+{{- range .CreatedFields }}
+		{{ $short }}.{{ .Name }} = {{ timeval . "now" }}
+{{- end }}
+{{- range .UpdatedFields }}
+		{{ $short }}.{{ .Name }} = {{ timeval . "now" }}
+{{- end }}
+{{- end }}
+
+		// sql query
+		const sqlstr = `INSERT INTO {{ $table }} (` +
+			`{{ colnames .Fields }}` +
+			`) VALUES (` +
+			`{{ colvals .Fields }}` +
+			`) ON DUPLICATE KEY UPDATE ` +
+			`{{ or (colnamesfmt .Fields "%[1]s = VALUES(%[1]s)" ", " .PrimaryKeyFields .CreatedFields) (colnamesfmt .PrimaryKeyFields "%[1]s = %[1]s" ", ") }}`
+
+		// run query
+		XOLog(sqlstr, {{ fieldnames .Fields $short }})
+		_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .Fields $short }})
+		if err != nil {
+			return err
+		}
+
+		// set existence
+		{{ $short }}._exists = true
+{{- if partialupdates $ }}
+		{{ $short }}.snapshot()
+{{- end }}
+
+		return nil
+	}
+{{- if or (not .Table.ManualPk) (eq (len .UniqueIndexes) 1) }}
+{{- range .UniqueIndexes }}
+
+	// {{ .UpsertFuncName }} performs an upsert for {{ $.Name }}, retrieving the
+{{- if $.Table.ManualPk }}
+	// primary key using the unique index '{{ .Index.IndexName }}'.
+{{- else }}
+	// primary key of the row inserted or updated on a conflict of the unique
+	// index '{{ .Index.IndexName }}'.
+{{- end }}
+	//
+	// NOTE: MySQL updates the existing row on a conflict of any unique index
+	func ({{ $short }} *{{ $.Name }}) {{ .UpsertFuncName }}({{ ctxparam }}db XODB) error {
+		var err error
+
+		// if already exist, bail
+		if {{ $short }}._exists {
+			return errors.New("insert failed: already exists")
+		}
+{{- if or $.CreatedFields $.UpdatedFields }}
+
+		// set timestamps
+		now := XONow()
+{{- range $.CreatedFields }}
+		{{ $short }}.{{ .Name }} = {{ timeval . "now" }}
+{{- end }}
+{{- range $.UpdatedFields }}
+		{{ $short }}.{{ .Name }} = {{ timeval . "now" }}
+{{- end }}
+{{- end }}
+
+	{{ if $.Table.ManualPk }}
+		// sql query, primary key must be provided
+		const sqlstr = `INSERT INTO {{ $table }} (` +
+			`{{ colnames $.Fields }}` +
+			`) VALUES (` +
+			`{{ colvals $.Fields }}` +
+			`) ON DUPLICATE KEY UPDATE ` +
+			`{{ or (colnamesfmt $.Fields "%[1]s = VALUES(%[1]s)" ", " $.PrimaryKeyFields $.CreatedFields) (colnamesfmt .Fields "%[1]s = %[1]s" ", ") }}`
+
+		// run query
+		XOLog(sqlstr, {{ fieldnames $.Fields $short }})
+		_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames $.Fields $short }})
+		if err != nil {
+			return err
+		}
+
+		// retrieve primary key{{ if $.CreatedFields }} and created timestamps{{ end }}, comparing
+		// the NULL values of the unique index as equal
+		const pksqlstr = `SELECT {{ colnames $.PrimaryKeyFields }}{{ if $.CreatedFields }}, {{ colnames $.CreatedFields }}{{ end }} ` +
+			`FROM {{ $table }} ` +
+			`WHERE {{ colnamesfmt .Fields "%[1]s <=> %[2]s" " AND " }}`
+
+		// run query
+		XOLog(pksqlstr, {{ fieldnames .Fields $short }})
+		err = db.{{ ctxmethod "QueryRow" }}({{ ctxarg }}pksqlstr, {{ fieldnames .Fields $short }}).Scan({{ fieldnames $.PrimaryKeyFields (print "&" $short) }}{{ if $.CreatedFields }}, {{ fieldnames $.CreatedFields (print "&" $short) }}{{ end }})
+		if err != nil {
+			return err
+		}
+	{{- else }}
+		// sql query, primary key provided by autoincrement, or set as the id of
+		// the updated row by LAST_INSERT_ID on a conflict
+		const sqlstr = `INSERT INTO {{ $table }} (` +
+			`{{ colnamesmulti $.Fields $.PrimaryKeyFields }}` +
+			`) VALUES (` +
+			`{{ colvalsmulti $.Fields $.PrimaryKeyFields }}` +
+			`) ON DUPLICATE KEY UPDATE ` +
+			`{{ colname $.PrimaryKey.Col }} = LAST_INSERT_ID({{ colname $.PrimaryKey.Col }}){{ with colnamesfmt $.Fields "%[1]s = VALUES(%[1]s)" ", " $.PrimaryKeyFields $.CreatedFields }}, {{ . }}{{ end }}`
+
+		// run query
+		XOLog(sqlstr, {{ fieldnamesmulti $.Fields $short $.PrimaryKeyFields }})
+		res, err := db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnamesmulti $.Fields $short $.PrimaryKeyFields }})
+		if err != nil {
+			return err
+		}
+
+		// retrieve id
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
 		}
 
-		return {{ $short }}.Insert(db)
+		// set primary key
+		{{ $short }}.{{ $.PrimaryKey.Name }} = {{ $.PrimaryKey.Type }}(id)
+	{{- if $.CreatedFields }}
+
+		// retrieve created timestamps
+		const pksqlstr = `SELECT {{ colnames $.CreatedFields }} ` +
+			`FROM {{ $table }} ` +
+			`WHERE {{ colnamesquery $.PrimaryKeyFields " AND " }}`
+
+		// run query
+		XOLog(pksqlstr, {{ fieldnames $.PrimaryKeyFields $short }})
+		err = db.{{ ctxmethod "QueryRow" }}({{ ctxarg }}pksqlstr, {{ fieldnames $.PrimaryKeyFields $short }}).Scan({{ fieldnames $.CreatedFields (print "&" $short) }})
+		if err != nil {
+			return err
+		}
+	{{- end }}
+	{{- end }}
+
+		// set existence
+		{{ $short }}._exists = true
+{{- if partialupdates $ }}
+		{{ $short }}.snapshot()
+{{- end }}
+
+		return nil
 	}
+{{- end }}
+{{- end }}
+	{{- end }}
+	{{- end }}
 {{ else }}
 	// Update statements omitted due to lack of fields other than primary key
 {{ end }}
 
+{{ block "delete" . -}}
+{{- $short := (shortname .Name "err" "res" "sqlstr" "db" "ctx" "XOLog" "affected" "now") -}}
+{{- $table := (schema .Schema .Table.TableName) -}}
+{{- if .SoftDelete }}
+// Delete soft deletes the {{ .Name }} from the database, setting its '{{ .SoftDelete.Col.ColumnName }}'
+// column.
+func ({{ $short }} *{{ .Name }}) Delete({{ ctxparam }}db XODB) error {
+	var err error
+
+	// if doesn't exist, bail
+	if !{{ $short }}._exists {
+		return nil
+	}
+
+	// if deleted, bail
+	if {{ $short }}._deleted {
+		return nil
+	}
+
+{{- if or (softdeletefields .) (softdeletenow . true) }}
+
+	// set timestamps
+	now := XONow()
+{{- range softdeletefields . }}
+	{{ $short }}.{{ .Name }} = {{ timeval . "now" }}
+{{- end }}
+{{- end }}
+
+	// sql query
+	const sqlstr = `UPDATE {{ $table }} SET {{ softdeleteset . true }}{{ if .Version }}, {{ versionset . }}{{ end }} WHERE {{ colnamesquerymulti (lockfields .) " AND " (softdeletecount . true) nil }}`
+
+	// run query
+	XOLog(sqlstr, {{ with softdeleteargs . true $short }}{{ . }}, {{ end }}{{ fieldnames (lockfields .) $short }})
+{{- if .Version }}
+	res, err := db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ with softdeleteargs . true $short }}{{ . }}, {{ end }}{{ fieldnames (lockfields .) $short }})
+	if err != nil {
+		return err
+	}
+
+	// if changed since loaded, bail
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrStaleObject
+	}
+
+	// increment version
+	{{ $short }}.{{ .Version.Name }}++
+{{- else }}
+	_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ with softdeleteargs . true $short }}{{ . }}, {{ end }}{{ fieldnames .PrimaryKeyFields $short }})
+	if err != nil {
+		return err
+	}
+{{- end }}
+
+	// set deleted
+{{- with softdeletefield . true }}
+	{{ $short }}.{{ $.SoftDelete.Name }} = {{ . }}
+{{- end }}
+{{- if and (partialupdates .) (or (softdeletefield . true) (softdeletefields .)) }}
+	if {{ $short }}._snapshot != nil {
+{{- with softdeletefield . true }}
+		{{ $short }}._snapshot.{{ $.SoftDelete.Name }} = {{ . }}
+{{- end }}
+{{- range softdeletefields $ }}
+		{{ $short }}._snapshot.{{ .Name }} = {{ $short }}.{{ .Name }}
+{{- end }}
+	}
+{{- end }}
+	{{ $short }}._deleted = true
+
+	return nil
+}
+
+// Restore restores the soft deleted {{ .Name }} in the database, clearing its
+// '{{ .SoftDelete.Col.ColumnName }}' column.
+func ({{ $short }} *{{ .Name }}) Restore({{ ctxparam }}db XODB) error {
+	var err error
+
+	// if doesn't exist, bail
+	if !{{ $short }}._exists {
+		return errors.New("restore failed: does not exist")
+	}
+
+{{- if or (softdeletefields .) (softdeletenow . false) }}
+
+	// set timestamps
+	now := XONow()
+{{- range softdeletefields . }}
+	{{ $short }}.{{ .Name }} = {{ timeval . "now" }}
+{{- end }}
+{{- end }}
+
+	// sql query
+	const sqlstr = `UPDATE {{ $table }} SET {{ softdeleteset . false }}{{ if .Version }}, {{ versionset . }}{{ end }} WHERE {{ colnamesquerymulti (lockfields .) " AND " (softdeletecount . false) nil }}`
+
+	// run query
+	XOLog(sqlstr, {{ with softdeleteargs . false $short }}{{ . }}, {{ end }}{{ fieldnames (lockfields .) $short }})
+{{- if .Version }}
+	res, err := db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ with softdeleteargs . false $short }}{{ . }}, {{ end }}{{ fieldnames (lockfields .) $short }})
+	if err != nil {
+		return err
+	}
+
+	// if changed since loaded, bail
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrStaleObject
+	}
+
+	// increment version
+	{{ $short }}.{{ .Version.Name }}++
+{{- else }}
+	_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ with softdeleteargs . false $short }}{{ . }}, {{ end }}{{ fieldnames .PrimaryKeyFields $short }})
+	if err != nil {
+		return err
+	}
+{{- end }}
+
+	// set restored
+{{- with softdeletefield . false }}
+	{{ $short }}.{{ $.SoftDelete.Name }} = {{ . }}
+{{- end }}
+{{- if and (partialupdates .) (or (softdeletefield . false) (softdeletefields .)) }}
+	if {{ $short }}._snapshot != nil {
+{{- with softdeletefield . false }}
+		{{ $short }}._snapshot.{{ $.SoftDelete.Name }} = {{ . }}
+{{- end }}
+{{- range softdeletefields $ }}
+		{{ $short }}._snapshot.{{ .Name }} = {{ $short }}.{{ .Name }}
+{{- end }}
+	}
+{{- end }}
+	{{ $short }}._deleted = false
+
+	return nil
+}
+
+// HardDelete deletes the {{ .Name }} from the database, whether or not it is soft
+// deleted.
+func ({{ $short }} *{{ .Name }}) HardDelete({{ ctxparam }}db XODB) error {
+	var err error
+
+	// if doesn't exist, bail
+	if !{{ $short }}._exists {
+		return nil
+	}
+{{- else }}
 // Delete deletes the {{ .Name }} from the database.
-func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
+func ({{ $short }} *{{ .Name }}) Delete({{ ctxparam }}db XODB) error {
 	var err error
 
 	// if doesn't exist, bail
@@ -179,6 +750,28 @@ func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
 	if {{ $short }}._deleted {
 		return nil
 	}
+{{- end }}
+{{- if .Version }}
+
+	// sql query, failing when the version changed since loaded
+	const sqlstr = `DELETE FROM {{ $table }} WHERE {{ colnamesquery (lockfields .) " AND " }}`
+
+	// run query
+	XOLog(sqlstr, {{ fieldnames (lockfields .) $short }})
+	res, err := db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames (lockfields .) $short }})
+	if err != nil {
+		return err
+	}
+
+	// if changed since loaded, bail
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrStaleObject
+	}
+{{- else }}
 
 	{{ if gt ( len .PrimaryKeyFields ) 1 }}
 		// sql query with composite primary key
@@ -186,7 +779,7 @@ func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
 
 		// run query
 		XOLog(sqlstr, {{ fieldnames .PrimaryKeyFields $short }})
-		_, err = db.Exec(sqlstr, {{ fieldnames .PrimaryKeyFields $short }})
+		_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .PrimaryKeyFields $short }})
 		if err != nil {
 			return err
 		}
@@ -196,16 +789,421 @@ func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
 
 		// run query
 		XOLog(sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
-		_, err = db.Exec(sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
+		_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
 		if err != nil {
 			return err
 		}
 	{{- end }}
+{{- end }}
 
 	// set deleted
 	{{ $short }}._deleted = true
+{{- if .SoftDelete }}
+	{{ $short }}._exists = false
+{{- end }}
 
 	return nil
 }
 {{- end }}
 
+{{ block "list" . -}}
+{{- $short := (shortname .Name "err" "res" "sqlstr" "db" "ctx" "XOLog" "q" "opts" "cols" "after" "args" "order" "where" "conds" "col" "dir" "cmp" "op" "i" "j" "last" "filters") -}}
+{{- $table := (schema .Schema .Table.TableName) -}}
+{{- $name := (print "List" (pluralize .Name)) -}}
+// {{ $name }}Order is an order of the {{ pluralize .Name }} listed by {{ $name }}.
+type {{ $name }}Order int
+
+const (
+{{- range $i, $o := .Orders }}
+{{- if $i }}
+{{ end }}
+	// {{ $name }}{{ $o.Name }} orders the {{ pluralize $.Name }} by {{ if $o.Index }}unique index '{{ $o.Index.IndexName }}'{{ else }}their primary key{{ end }}.
+	{{ $name }}{{ $o.Name }}{{ if not $i }} {{ $name }}Order = iota{{ end }}
+{{- end }}
+)
+
+// {{ $name }}Cursor is the position of the last {{ .Name }} of a page listed by
+// {{ $name }}, after which the next page is listed.
+type {{ $name }}Cursor struct {
+{{- range (orderfields .) }}
+	{{ .Name }} {{ retype .Type }} {{ fieldtag $ . }} // {{ .Col.ColumnName }}
+{{- end }}
+}
+
+// {{ $name }}Opts are the options of {{ $name }}.
+type {{ $name }}Opts struct {
+	// Order is the order of the {{ pluralize .Name }}, by their primary key by default.
+	Order {{ $name }}Order
+
+	// Desc lists the {{ pluralize .Name }} in descending order.
+	Desc bool
+
+	// Limit is the maximum number of {{ pluralize .Name }} listed, or 0 to list all of them.
+	Limit int
+
+	// Offset is the number of {{ pluralize .Name }} skipped.
+	Offset int
+
+	// After is the cursor of the previous page, to list the {{ pluralize .Name }} after it.
+	// The previous page must have been listed with the same Order and Desc.
+	After *{{ $name }}Cursor
+{{- if .SoftDelete }}
+
+	// IncludingDeleted lists the soft deleted {{ pluralize .Name }} too.
+	IncludingDeleted bool
+{{- end }}
+}
+
+// {{ $name }} lists a page of the {{ pluralize .Name }} from '{{ $table }}'.
+//
+// The cursor of the next page is returned along with the page, and is nil when
+// fewer than opts.Limit {{ pluralize .Name }} are listed.
+func {{ $name }}({{ ctxparam }}db XODB, opts {{ $name }}Opts) ([]*{{ .Name }}, *{{ $name }}Cursor, error) {
+	var err error
+
+	// columns of the order, and values of the cursor
+	var cols []string
+	var after []interface{}
+	switch opts.Order {
+{{- range .Orders }}
+	case {{ $name }}{{ .Name }}:
+		cols = []string{ {{- range $i, $f := .Fields }}{{ if $i }}, {{ end }}`{{ colname $f.Col }}`{{ end -}} }
+		if opts.After != nil {
+			after = []interface{}{ {{- fieldnames .Fields "opts.After" -}} }
+		}
+{{- end }}
+	default:
+		return nil, nil, errors.New("list failed: unknown order")
+	}
+
+	dir, cmp := ` ASC`, ` > `
+	if opts.Desc {
+		dir, cmp = ` DESC`, ` < `
+	}
+
+	// build the order, and the keyset condition of the cursor (ie,
+	// 'a > x OR (a = x AND b > y)')
+	var args []interface{}
+	var order, where []string
+	for i, col := range cols {
+		order = append(order, col+dir)
+		if after == nil {
+			continue
+		}
+
+		var conds []string
+		for j := 0; j <= i; j++ {
+			op := ` = `
+			if j == i {
+				op = cmp
+			}
+			args = append(args, after[j])
+			conds = append(conds, cols[j]+op+{{ paramexpr "len(args)" }})
+		}
+		where = append(where, `(`+strings.Join(conds, ` AND `)+`)`)
+	}
+
+	// sql query
+	sqlstr := `SELECT ` +
+		`{{ colnames .Fields }}{{ if .SoftDelete }}, {{ softdeletedcol . }}{{ end }} ` +
+		`FROM {{ $table }}`
+{{- if .SoftDelete }}
+	var filters []string
+	if len(where) != 0 {
+		filters = append(filters, `(`+strings.Join(where, ` OR `)+`)`)
+	}
+	if !opts.IncludingDeleted {
+		filters = append(filters, `{{ softdeletecond . "" }}`)
+	}
+	if len(filters) != 0 {
+		sqlstr += ` WHERE ` + strings.Join(filters, ` AND `)
+	}
+{{- else }}
+	if len(where) != 0 {
+		sqlstr += ` WHERE ` + strings.Join(where, ` OR `)
+	}
+{{- end }}
+	sqlstr += ` ORDER BY ` + strings.Join(order, `, `)
+	switch {
+	case opts.Limit > 0:
+		sqlstr += fmt.Sprintf(` LIMIT %d OFFSET %d`, opts.Limit, opts.Offset)
+	case opts.Offset > 0:
+		// an offset requires a limit, so use the largest one
+		sqlstr += fmt.Sprintf(` LIMIT 18446744073709551615 OFFSET %d`, opts.Offset)
+	}
+
+	// run query
+	XOLog(sqlstr, args...)
+	q, err := db.{{ ctxmethod "Query" }}({{ ctxarg }}sqlstr, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*{{ .Name }}{}
+	for q.Next() {
+		{{ $short }} := {{ .Name }}{
+			_exists: true,
+		}
+
+		// scan
+		err = q.Scan({{ fieldnames .Fields (print "&" $short) }}{{ if .SoftDelete }}, &{{ $short }}._deleted{{ end }})
+		if err != nil {
+			return nil, nil, err
+		}
+{{- if partialupdates $ }}
+		{{ $short }}.snapshot()
+{{- end }}
+
+		res = append(res, &{{ $short }})
+	}
+	err = q.Err()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the page is the last one when not full
+	if opts.Limit <= 0 || len(res) < opts.Limit {
+		return res, nil, nil
+	}
+
+	last := res[len(res)-1]
+	return res, &{{ $name }}Cursor{
+{{- range (orderfields .) }}
+		{{ .Name }}: last.{{ .Name }},
+{{- end }}
+	}, nil
+}
+{{- end }}
+{{- end }}
+
+{{ block "builder" . -}}
+{{- $short := (shortname .Name "err" "res" "sqlstr" "db" "ctx" "XOLog" "q" "rows" "args" "where" "orders" "limit" "i" "o" "c" "v" "vs") -}}
+{{- $table := (schema .Schema .Table.TableName) -}}
+{{- $name := (pluralize .Name) -}}
+{{- range .Fields }}
+{{- $col := (print $.Name .Name "Column") }}
+// {{ $col }} is the '{{ .Col.ColumnName }}' column of '{{ $table }}'.
+type {{ $col }} struct{ XOColumn }
+
+// Eq returns the condition of the column being equal to v.
+func (c {{ $col }}) Eq(v {{ retype .Type }}) XOCond {
+	return c.cond(` = `, v)
+}
+
+// Ne returns the condition of the column not being equal to v.
+func (c {{ $col }}) Ne(v {{ retype .Type }}) XOCond {
+	return c.cond(` <> `, v)
+}
+
+// Lt returns the condition of the column being less than v.
+func (c {{ $col }}) Lt(v {{ retype .Type }}) XOCond {
+	return c.cond(` < `, v)
+}
+
+// Le returns the condition of the column being less than or equal to v.
+func (c {{ $col }}) Le(v {{ retype .Type }}) XOCond {
+	return c.cond(` <= `, v)
+}
+
+// Gt returns the condition of the column being greater than v.
+func (c {{ $col }}) Gt(v {{ retype .Type }}) XOCond {
+	return c.cond(` > `, v)
+}
+
+// Ge returns the condition of the column being greater than or equal to v.
+func (c {{ $col }}) Ge(v {{ retype .Type }}) XOCond {
+	return c.cond(` >= `, v)
+}
+
+// In returns the condition of the column being any of the vs.
+func (c {{ $col }}) In(vs ...{{ retype .Type }}) XOCond {
+	args := make([]interface{}, len(vs))
+	for i, v := range vs {
+		args[i] = v
+	}
+	return c.in(args)
+}
+{{- if or (eq .Type "string") (eq .Type "sql.NullString") }}
+
+// Like returns the condition of the column matching the pattern.
+func (c {{ $col }}) Like(pattern string) XOCond {
+	return c.cond(` LIKE `, pattern)
+}
+{{- end }}
+{{ end }}
+// {{ .Name }}Columns are the columns of '{{ $table }}', creating the conditions
+// and the orders of the queries built by {{ $name }}.
+var {{ .Name }}Columns = struct {
+{{- range .Fields }}
+	{{ .Name }} {{ $.Name }}{{ .Name }}Column
+{{- end }}
+}{
+{{- range .Fields }}
+	{{ .Name }}: {{ $.Name }}{{ .Name }}Column{XOColumn{`{{ colname .Col }}`}},
+{{- end }}
+}
+
+// {{ .Name }}Query is a query of the {{ $name }} from '{{ $table }}', built by {{ $name }}.
+type {{ .Name }}Query struct {
+	db     XODB
+	conds  []XOCond
+	orders []XOOrder
+	limit  int
+	offset int
+{{- if .SoftDelete }}
+
+	// deleted includes the soft deleted {{ $name }}.
+	deleted bool
+{{- end }}
+}
+
+// {{ $name }} returns a query of the {{ $name }} from '{{ $table }}', which is
+// narrowed by the conditions of the {{ .Name }}Columns.
+func {{ $name }}(db XODB) *{{ .Name }}Query {
+	return &{{ .Name }}Query{db: db}
+}
+
+// Where narrows the query to the {{ $name }} satisfying all of the conds.
+func (q *{{ .Name }}Query) Where(conds ...XOCond) *{{ .Name }}Query {
+	q.conds = append(q.conds, conds...)
+	return q
+}
+
+// OrderBy orders the {{ $name }} of the query by the orders, following the
+// previous ones.
+func (q *{{ .Name }}Query) OrderBy(orders ...XOOrder) *{{ .Name }}Query {
+	q.orders = append(q.orders, orders...)
+	return q
+}
+
+// Limit limits the query to n {{ $name }}.
+func (q *{{ .Name }}Query) Limit(n int) *{{ .Name }}Query {
+	q.limit = n
+	return q
+}
+
+// Offset skips the first n {{ $name }} of the query.
+func (q *{{ .Name }}Query) Offset(n int) *{{ .Name }}Query {
+	q.offset = n
+	return q
+}
+
+{{- if .SoftDelete }}
+
+// IncludingDeleted includes the soft deleted {{ $name }} in the query.
+func (q *{{ .Name }}Query) IncludingDeleted() *{{ .Name }}Query {
+	q.deleted = true
+	return q
+}
+{{- end }}
+
+// SQL returns the SQL of the query, and its args.
+func (q *{{ .Name }}Query) SQL() (string, []interface{}) {
+	var args []interface{}
+
+	// sql query
+	sqlstr := `SELECT ` +
+		`{{ colnames .Fields }}{{ if and .SoftDelete .PrimaryKey }}, {{ softdeletedcol . }}{{ end }} ` +
+		`FROM {{ $table }}`
+{{- if .SoftDelete }}
+	conds := q.conds
+	if !q.deleted {
+		conds = append([]XOCond{ {parts: []string{`{{ softdeletecond . "" }}`}} }, conds...)
+	}
+	if len(conds) != 0 {
+		where := XOAnd(conds...)
+{{- else }}
+	if len(q.conds) != 0 {
+		where := XOAnd(q.conds...)
+{{- end }}
+		sqlstr += ` WHERE ` + where.render(0)
+		args = where.args
+	}
+	if len(q.orders) != 0 {
+		orders := make([]string, len(q.orders))
+		for i, o := range q.orders {
+			orders[i] = o.expr
+		}
+		sqlstr += ` ORDER BY ` + strings.Join(orders, `, `)
+	}
+	switch {
+	case q.limit > 0:
+		sqlstr += fmt.Sprintf(` LIMIT %d OFFSET %d`, q.limit, q.offset)
+	case q.offset > 0:
+		// an offset requires a limit, so use the largest one
+		sqlstr += fmt.Sprintf(` LIMIT 18446744073709551615 OFFSET %d`, q.offset)
+	}
+
+	return sqlstr, args
+}
+
+// All retrieves the {{ $name }} of the query.
+func (q *{{ .Name }}Query) All({{ ctxparam }}) ([]*{{ .Name }}, error) {
+	sqlstr, args := q.SQL()
+
+	// run query
+	XOLog(sqlstr, args...)
+	rows, err := q.db.{{ ctxmethod "Query" }}({{ ctxarg }}sqlstr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// load results
+	res := []*{{ .Name }}{}
+	for rows.Next() {
+		{{ $short }} := {{ .Name }}{
+		{{- if .PrimaryKey }}
+			_exists: true,
+		{{ end -}}
+		}
+
+		// scan
+		err = rows.Scan({{ fieldnames .Fields (print "&" $short) }}{{ if and .SoftDelete .PrimaryKey }}, &{{ $short }}._deleted{{ end }})
+		if err != nil {
+			return nil, err
+		}
+{{- if partialupdates $ }}
+		{{ $short }}.snapshot()
+{{- end }}
+
+		res = append(res, &{{ $short }})
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// One retrieves the first {{ .Name }} of the query, returning sql.ErrNoRows when
+// there is none.
+func (q *{{ .Name }}Query) One({{ ctxparam }}) (*{{ .Name }}, error) {
+	limit := q.limit
+	q.limit = 1
+	sqlstr, args := q.SQL()
+	q.limit = limit
+
+	// run query
+	XOLog(sqlstr, args...)
+	{{ $short }} := {{ .Name }}{
+	{{- if .PrimaryKey }}
+		_exists: true,
+	{{ end -}}
+	}
+
+	err := q.db.{{ ctxmethod "QueryRow" }}({{ ctxarg }}sqlstr, args...).Scan({{ fieldnames .Fields (print "&" $short) }}{{ if and .SoftDelete .PrimaryKey }}, &{{ $short }}._deleted{{ end }})
+	if err != nil {
+		return nil, err
+	}
+{{- if partialupdates $ }}
+	{{ $short }}.snapshot()
+{{- end }}
+
+	return &{{ $short }}, nil
+}
+{{- end }}
+
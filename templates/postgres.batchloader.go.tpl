@@ -0,0 +1,71 @@
+{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "ctx" "q" "res" "XOLog" .ParamName) -}}
+{{- $table := (schema .Schema .Type.Table.TableName) -}}
+{{- range $i, $all := (includingdeleted .Type) -}}
+{{- if $i }}
+
+{{ end -}}
+// {{ $.FuncName }}{{ if $all }}IncludingDeleted{{ end }} retrieves the rows from '{{ $table }}' as {{ pluralize $.Type.Name }}, for each of the {{ $.ParamName }}.
+//
+// The {{ pluralize $.Type.Name }} are keyed by their {{ $.Field.Name }}.
+{{- if $all }}
+//
+// The soft deleted {{ pluralize $.Type.Name }} are included.
+{{- else if $.Type.SoftDelete }}
+//
+// The soft deleted {{ pluralize $.Type.Name }} are skipped.
+{{- end }}
+{{- if $.Index }}
+//
+// Generated from index '{{ $.Index.IndexName }}'.
+{{- else }}
+//
+// Generated from foreign key '{{ $.ForeignKey.ForeignKeyName }}'.
+{{- end }}
+func {{ $.FuncName }}{{ if $all }}IncludingDeleted{{ end }}({{ ctxparam }}db XODB, {{ $.ParamName }} []{{ retype $.Field.Type }}) (map[{{ retype $.Field.Type }}]{{ if not $.Unique }}[]{{ end }}*{{ $.Type.Name }}, error) {
+	var err error
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`{{ colnames $.Type.Fields }}{{ if and $all $.Type.PrimaryKey }}, {{ softdeletedcol $.Type }}{{ end }} ` +
+		`FROM {{ $table }} ` +
+		`WHERE {{ colname $.Field.Col }} = ANY($1){{ if and $.Type.SoftDelete (not $all) }} AND {{ softdeletecond $.Type "" }}{{ end }}`
+
+	// run query
+	XOLog(sqlstr, {{ $.ParamName }})
+	q, err := db.{{ ctxmethod "Query" }}({{ ctxarg }}sqlstr, pq.Array({{ $.ParamName }}))
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := map[{{ retype $.Field.Type }}]{{ if not $.Unique }}[]{{ end }}*{{ $.Type.Name }}{}
+	for q.Next() {
+		{{ $short }} := {{ $.Type.Name }}{
+		{{- if $.Type.PrimaryKey }}
+			_exists: true,
+		{{ end -}}
+		}
+
+		// scan
+		err = q.Scan({{ fieldnames $.Type.Fields (print "&" $short) }}{{ if and $all $.Type.PrimaryKey }}, &{{ $short }}._deleted{{ end }})
+		if err != nil {
+			return nil, err
+		}
+{{- if partialupdates $.Type }}
+		{{ $short }}.snapshot()
+{{- end }}
+{{ if $.Unique }}
+		res[{{ $short }}.{{ $.Field.Name }}] = &{{ $short }}
+{{- else }}
+		res[{{ $short }}.{{ $.Field.Name }}] = append(res[{{ $short }}.{{ $.Field.Name }}], &{{ $short }})
+{{- end }}
+	}
+	err = q.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+{{- end }}
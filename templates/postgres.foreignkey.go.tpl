@@ -1,8 +1,82 @@
-{{- $short := (shortname .Type.Name) -}}
-// {{ .Name }} returns the {{ .RefType.Name }} associated with the {{ .Type.Name }}'s {{ .Field.Name }} ({{ .Field.Col.ColumnName }}).
+{{- $short := (shortname .Type.Name "ctx") -}}
+{{- range $i, $all := (includingdeleted .RefType) -}}
+{{- if $i }}
+
+{{ end -}}
+// {{ $.Name }}{{ if $all }}IncludingDeleted{{ end }} returns the {{ $.RefType.Name }} associated with the {{ $.Type.Name }}'s {{ $.Field.Name }} ({{ $.Field.Col.ColumnName }}).
+{{- if $all }}
+//
+// A soft deleted {{ $.RefType.Name }} is included.
+{{- else if $.RefType.SoftDelete }}
 //
-// Generated from foreign key '{{ .ForeignKey.ForeignKeyName }}'.
-func ({{ $short }} *{{ .Type.Name }}) {{ .Name }}(db XODB) (*{{ .RefType.Name }}, error) {
-	return {{ .RefType.Name }}By{{ .RefField.Name }}(db, {{ convext $short .Field .RefField }})
+// A soft deleted {{ $.RefType.Name }} is skipped.
+{{- end }}
+//
+// Generated from foreign key '{{ $.ForeignKey.ForeignKeyName }}'.
+func ({{ $short }} *{{ $.Type.Name }}) {{ $.Name }}{{ if $all }}IncludingDeleted{{ end }}({{ ctxparam }}db XODB) (*{{ $.RefType.Name }}, error) {
+	return {{ $.RefType.Name }}By{{ $.RefField.Name }}{{ if $all }}IncludingDeleted{{ end }}({{ ctxarg }}db, {{ convext $short $.Field $.RefField }})
 }
+{{- end }}
+
+{{ $refshort := (shortname .RefType.Name "err" "sqlstr" "db" "ctx" "q" "res" "XOLog") -}}
+{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "ctx" "q" "res" "XOLog" $refshort) -}}
+{{- $table := (schema .Type.Schema .Type.Table.TableName) -}}
+{{- range $i, $all := (includingdeleted .Type) -}}
+{{- if $i }}
+
+{{ end -}}
+// {{ $.RevName }}{{ if $all }}IncludingDeleted{{ end }} returns the {{ pluralize $.Type.Name }} associated with the {{ $.RefType.Name }}'s {{ $.RefField.Name }} ({{ $.RefField.Col.ColumnName }}).
+{{- if $all }}
+//
+// The soft deleted {{ pluralize $.Type.Name }} are included.
+{{- else if $.Type.SoftDelete }}
+//
+// The soft deleted {{ pluralize $.Type.Name }} are skipped.
+{{- end }}
+//
+// Generated from foreign key '{{ $.ForeignKey.ForeignKeyName }}'.
+func ({{ $refshort }} *{{ $.RefType.Name }}) {{ $.RevName }}{{ if $all }}IncludingDeleted{{ end }}({{ ctxparam }}db XODB) ([]*{{ $.Type.Name }}, error) {
+	var err error
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`{{ colnames $.Type.Fields }}{{ if and $all $.Type.PrimaryKey }}, {{ softdeletedcol $.Type }}{{ end }} ` +
+		`FROM {{ $table }} ` +
+		`WHERE {{ colname $.Field.Col }} = {{ nthparam 0 }}{{ if and $.Type.SoftDelete (not $all) }} AND {{ softdeletecond $.Type "" }}{{ end }}`
+
+	// run query
+	XOLog(sqlstr, {{ $refshort }}.{{ $.RefField.Name }})
+	q, err := db.{{ ctxmethod "Query" }}({{ ctxarg }}sqlstr, {{ $refshort }}.{{ $.RefField.Name }})
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*{{ $.Type.Name }}{}
+	for q.Next() {
+		{{ $short }} := {{ $.Type.Name }}{
+		{{- if $.Type.PrimaryKey }}
+			_exists: true,
+		{{ end -}}
+		}
 
+		// scan
+		err = q.Scan({{ fieldnames $.Type.Fields (print "&" $short) }}{{ if and $all $.Type.PrimaryKey }}, &{{ $short }}._deleted{{ end }})
+		if err != nil {
+			return nil, err
+		}
+{{- if partialupdates $.Type }}
+		{{ $short }}.snapshot()
+{{- end }}
+
+		res = append(res, &{{ $short }})
+	}
+	err = q.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+{{- end }}
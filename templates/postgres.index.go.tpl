@@ -1,53 +1,70 @@
-{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "XOLog" .Fields) -}}
+{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "ctx" "q" "res" "XOLog" .Fields) -}}
 {{- $table := (schema .Schema .Type.Table.TableName) -}}
-// {{ .FuncName }} retrieves a row from '{{ $table }}' as a {{ .Type.Name }}.
+{{- range $i, $all := (includingdeleted .Type) -}}
+{{- if $i }}
+
+{{ end -}}
+// {{ $.FuncName }}{{ if $all }}IncludingDeleted{{ end }} retrieves a row from '{{ $table }}' as a {{ $.Type.Name }}.
+{{- if $all }}
+//
+// The soft deleted {{ pluralize $.Type.Name }} are included.
+{{- else if $.Type.SoftDelete }}
+//
+// The soft deleted {{ pluralize $.Type.Name }} are skipped.
+{{- end }}
 //
-// Generated from index '{{ .Index.IndexName }}'.
-func {{ .FuncName }}(db XODB{{ goparamlist .Fields true true }}) ({{ if not .Index.IsUnique }}[]{{ end }}*{{ .Type.Name }}, error) {
+// Generated from index '{{ $.Index.IndexName }}'.
+func {{ $.FuncName }}{{ if $all }}IncludingDeleted{{ end }}({{ ctxparam }}db XODB{{ goparamlist $.Fields true true }}) ({{ if not $.Index.IsUnique }}[]{{ end }}*{{ $.Type.Name }}, error) {
 	var err error
 
 	// sql query
 	const sqlstr = `SELECT ` +
-		`{{ colnames .Type.Fields }} ` +
+		`{{ colnames $.Type.Fields }}{{ if and $all $.Type.PrimaryKey }}, {{ softdeletedcol $.Type }}{{ end }} ` +
 		`FROM {{ $table }} ` +
-		`WHERE {{ colnamesquery .Fields " AND " }}`
+		`WHERE {{ colnamesquery $.Fields " AND " }}{{ if and $.Type.SoftDelete (not $all) }} AND {{ softdeletecond $.Type "" }}{{ end }}`
 
 	// run query
-	XOLog(sqlstr{{ goparamlist .Fields true false }})
-{{- if .Index.IsUnique }}
-	{{ $short }} := {{ .Type.Name }}{
-	{{- if .Type.PrimaryKey }}
+	XOLog(sqlstr{{ goparamlist $.Fields true false }})
+{{- if $.Index.IsUnique }}
+	{{ $short }} := {{ $.Type.Name }}{
+	{{- if $.Type.PrimaryKey }}
 		_exists: true,
 	{{ end -}}
 	}
 
-	err = db.QueryRow(sqlstr{{ goparamlist .Fields true false }}).Scan({{ fieldnames .Type.Fields (print "&" $short) }})
+	err = db.{{ ctxmethod "QueryRow" }}({{ ctxarg }}sqlstr{{ goparamlist $.Fields true false }}).Scan({{ fieldnames $.Type.Fields (print "&" $short) }}{{ if and $all $.Type.PrimaryKey }}, &{{ $short }}._deleted{{ end }})
 	if err != nil {
 		return nil, err
 	}
+{{- if partialupdates $.Type }}
+	{{ $short }}.snapshot()
+{{- end }}
 
 	return &{{ $short }}, nil
 {{- else }}
-	q, err := db.Query(sqlstr{{ goparamlist .Fields true false }})
+	q, err := db.{{ ctxmethod "Query" }}({{ ctxarg }}sqlstr{{ goparamlist $.Fields true false }})
 	if err != nil {
 		return nil, err
 	}
 	defer q.Close()
 
 	// load results
-	res := []*{{ .Type.Name }}{}
+	res := []*{{ $.Type.Name }}{}
 	for q.Next() {
-		{{ $short }} := {{ .Type.Name }}{
-		{{- if .Type.PrimaryKey }}
+		{{ $short }} := {{ $.Type.Name }}{
+		{{- if $.Type.PrimaryKey }}
 			_exists: true,
 		{{ end -}}
 		}
 
 		// scan
-		err = q.Scan({{ fieldnames .Type.Fields (print "&" $short) }})
+		err = q.Scan({{ fieldnames $.Type.Fields (print "&" $short) }}{{ if and $all $.Type.PrimaryKey }}, &{{ $short }}._deleted{{ end }})
 		if err != nil {
 			return nil, err
 		}
+{{- if partialupdates $.Type }}
+		{{ $short }}.snapshot()
+{{- end }}
 
 		res = append(res, &{{ $short }})
 	}
@@ -55,4 +72,4 @@ func {{ .FuncName }}(db XODB{{ goparamlist .Fields true true }}) ({{ if not .Ind
 	return res, nil
 {{- end }}
 }
-
+{{- end }}
@@ -0,0 +1,92 @@
+{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "ctx" "q" "res" "XOLog") -}}
+{{- $refshort := (shortname .RefType.Name "err" "sqlstr" "db" "ctx" "q" "res" "XOLog" $short) -}}
+{{- $table := (schema .RefType.Schema .RefType.Table.TableName) -}}
+{{- $jointable := (schema .JoinType.Schema .JoinType.Table.TableName) -}}
+// {{ .Name }} returns the {{ pluralize .RefType.Name }} associated with the {{ .Type.Name }} through '{{ $jointable }}'.
+{{- if or .RefType.SoftDelete .JoinType.SoftDelete }}
+//
+// The soft deleted rows are skipped.
+{{- end }}
+//
+// Generated from join table '{{ $jointable }}'.
+func ({{ $short }} *{{ .Type.Name }}) {{ .Name }}({{ ctxparam }}db XODB) ([]*{{ .RefType.Name }}, error) {
+	var err error
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`{{ colprefixnames .RefType.Fields "t" }} ` +
+		`FROM {{ $table }} t ` +
+		`JOIN {{ $jointable }} j ON j.{{ colname .RefForeignKey.Field.Col }} = t.{{ colname .RefForeignKey.RefField.Col }} ` +
+		`WHERE j.{{ colname .ForeignKey.Field.Col }} = {{ nthparam 0 }}{{ if .RefType.SoftDelete }} AND {{ softdeletecond .RefType "t." }}{{ end }}{{ if .JoinType.SoftDelete }} AND {{ softdeletecond .JoinType "j." }}{{ end }}`
+
+	// run query
+	XOLog(sqlstr, {{ $short }}.{{ .ForeignKey.RefField.Name }})
+	q, err := db.{{ ctxmethod "Query" }}({{ ctxarg }}sqlstr, {{ $short }}.{{ .ForeignKey.RefField.Name }})
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*{{ .RefType.Name }}{}
+	for q.Next() {
+		{{ $refshort }} := {{ .RefType.Name }}{
+		{{- if .RefType.PrimaryKey }}
+			_exists: true,
+		{{ end -}}
+		}
+
+		// scan
+		err = q.Scan({{ fieldnames .RefType.Fields (print "&" $refshort) }})
+		if err != nil {
+			return nil, err
+		}
+{{- if partialupdates .RefType }}
+		{{ $refshort }}.snapshot()
+{{- end }}
+
+		res = append(res, &{{ $refshort }})
+	}
+	err = q.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+{{ if eq (len .JoinType.Fields) 2 }}
+// {{ .AddName }} adds the {{ .RefType.Name }} to the {{ .Type.Name }}'s {{ .Name }}, inserting a row into '{{ $jointable }}'.
+//
+// Generated from join table '{{ $jointable }}'.
+func ({{ $short }} *{{ .Type.Name }}) {{ .AddName }}({{ ctxparam }}db XODB, {{ $refshort }} *{{ .RefType.Name }}) error {
+	var err error
+
+	// sql insert query
+	const sqlstr = `INSERT INTO {{ $jointable }} (` +
+		`{{ colname .ForeignKey.Field.Col }}, {{ colname .RefForeignKey.Field.Col }}` +
+		`) VALUES (` +
+		`{{ nthparam 0 }}, {{ nthparam 1 }}` +
+		`)`
+
+	// run query
+	XOLog(sqlstr, {{ $short }}.{{ .ForeignKey.RefField.Name }}, {{ $refshort }}.{{ .RefForeignKey.RefField.Name }})
+	_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ $short }}.{{ .ForeignKey.RefField.Name }}, {{ $refshort }}.{{ .RefForeignKey.RefField.Name }})
+	return err
+}
+{{ end }}
+// {{ .RemoveName }} removes the {{ .RefType.Name }} from the {{ .Type.Name }}'s {{ .Name }}, deleting its row from '{{ $jointable }}'.
+//
+// Generated from join table '{{ $jointable }}'.
+func ({{ $short }} *{{ .Type.Name }}) {{ .RemoveName }}({{ ctxparam }}db XODB, {{ $refshort }} *{{ .RefType.Name }}) error {
+	var err error
+
+	// sql query
+	const sqlstr = `DELETE FROM {{ $jointable }} ` +
+		`WHERE {{ colname .ForeignKey.Field.Col }} = {{ nthparam 0 }} AND {{ colname .RefForeignKey.Field.Col }} = {{ nthparam 1 }}`
+
+	// run query
+	XOLog(sqlstr, {{ $short }}.{{ .ForeignKey.RefField.Name }}, {{ $refshort }}.{{ .RefForeignKey.RefField.Name }})
+	_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr, {{ $short }}.{{ .ForeignKey.RefField.Name }}, {{ $refshort }}.{{ .RefForeignKey.RefField.Name }})
+	return err
+}
+
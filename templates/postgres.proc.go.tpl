@@ -2,7 +2,7 @@
 {{- $proc := (schema .Schema .Proc.ProcName) -}}
 {{- if ne .Proc.ReturnType "trigger" -}}
 // {{ .Name }} calls the stored procedure '{{ $proc }}({{ .ProcParams }}) {{ .Proc.ReturnType }}' on db.
-func {{ .Name }}(db XODB{{ goparamlist .Params true true }}) ({{ if $notVoid }}{{ retype .Return.Type }}, {{ end }}error) {
+func {{ .Name }}({{ ctxparam }}db XODB{{ goparamlist .Params true true }}) ({{ if $notVoid }}{{ retype .Return.Type }}, {{ end }}error) {
 	var err error
 
 	// sql query
@@ -12,7 +12,7 @@ func {{ .Name }}(db XODB{{ goparamlist .Params true true }}) ({{ if $notVoid }}{
 {{- if $notVoid }}
 	var ret {{ retype .Return.Type }}
 	XOLog(sqlstr{{ goparamlist .Params true false }})
-	err = db.QueryRow(sqlstr{{ goparamlist .Params true false }}).Scan(&ret)
+	err = db.{{ ctxmethod "QueryRow" }}({{ ctxarg }}sqlstr{{ goparamlist .Params true false }}).Scan(&ret)
 	if err != nil {
 		return {{ reniltype .Return.NilType }}, err
 	}
@@ -20,7 +20,7 @@ func {{ .Name }}(db XODB{{ goparamlist .Params true true }}) ({{ if $notVoid }}{
 	return ret, nil
 {{- else }}
 	XOLog(sqlstr)
-	_, err = db.Exec(sqlstr)
+	_, err = db.{{ ctxmethod "Exec" }}({{ ctxarg }}sqlstr)
 	return err
 {{- end }}
 }
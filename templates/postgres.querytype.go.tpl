@@ -6,7 +6,7 @@
 {{- end }}
 type {{ .Name }} struct {
 {{- range .Fields }}
-	{{ .Name }} {{ retype .Type }} `json:"{{ .Col.ColumnName }}"` // {{ .Col.ColumnName }}	
+	{{ .Name }} {{ retype .Type }} {{ fieldtag $ . }} // {{ .Col.ColumnName }}	
 {{- end }}
 }
 
@@ -0,0 +1,93 @@
+// Package templates contains the built in gendal templates.
+package templates
+
+import (
+	"embed"
+	"sort"
+	"strings"
+)
+
+// files are the built in templates.
+//
+// The templates that are identical for several databases are symlinks to the
+// postgres or mysql templates in the source tree. As symlinks cannot be
+// embedded, only the regular files are, and the symlinks are resolved using
+// links.
+//
+//go:embed xo_db.go.tpl xo_package.go.tpl postgres.*.go.tpl
+//go:embed mssql.type.go.tpl mysql.type.go.tpl oracle.type.go.tpl sqlite3.type.go.tpl
+//go:embed mysql.batchloader.go.tpl
+var files embed.FS
+
+// links are the names of the symlinked templates to the names of their
+// targets.
+var links = map[string]string{
+	"mssql.batchloader.go.tpl":   "mysql.batchloader.go.tpl",
+	"mssql.foreignkey.go.tpl":    "postgres.foreignkey.go.tpl",
+	"mssql.index.go.tpl":         "postgres.index.go.tpl",
+	"mssql.manytomany.go.tpl":    "postgres.manytomany.go.tpl",
+	"mssql.query.go.tpl":         "postgres.query.go.tpl",
+	"mssql.querytype.go.tpl":     "postgres.querytype.go.tpl",
+	"mysql.enum.go.tpl":          "postgres.enum.go.tpl",
+	"mysql.foreignkey.go.tpl":    "postgres.foreignkey.go.tpl",
+	"mysql.index.go.tpl":         "postgres.index.go.tpl",
+	"mysql.manytomany.go.tpl":    "postgres.manytomany.go.tpl",
+	"mysql.proc.go.tpl":          "postgres.proc.go.tpl",
+	"mysql.query.go.tpl":         "postgres.query.go.tpl",
+	"mysql.querytype.go.tpl":     "postgres.querytype.go.tpl",
+	"oracle.batchloader.go.tpl":  "mysql.batchloader.go.tpl",
+	"oracle.foreignkey.go.tpl":   "postgres.foreignkey.go.tpl",
+	"oracle.index.go.tpl":        "postgres.index.go.tpl",
+	"oracle.manytomany.go.tpl":   "postgres.manytomany.go.tpl",
+	"oracle.query.go.tpl":        "postgres.query.go.tpl",
+	"oracle.querytype.go.tpl":    "postgres.querytype.go.tpl",
+	"sqlite3.batchloader.go.tpl": "mysql.batchloader.go.tpl",
+	"sqlite3.foreignkey.go.tpl":  "postgres.foreignkey.go.tpl",
+	"sqlite3.index.go.tpl":       "postgres.index.go.tpl",
+	"sqlite3.manytomany.go.tpl":  "postgres.manytomany.go.tpl",
+	"sqlite3.query.go.tpl":       "postgres.query.go.tpl",
+	"sqlite3.querytype.go.tpl":   "postgres.querytype.go.tpl",
+}
+
+// Asset returns the contents of the built in template with name.
+func Asset(name string) ([]byte, error) {
+	if target, ok := links[name]; ok {
+		name = target
+	}
+
+	return files.ReadFile(name)
+}
+
+// AssetNames returns the sorted names of the built in templates, including
+// the symlinked templates.
+func AssetNames() []string {
+	names := []string{}
+
+	entries, _ := files.ReadDir(".")
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	for name := range links {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// DatabaseNames returns the sorted names of the databases with built in
+// templates (ie, 'postgres' or 'sqlite3').
+func DatabaseNames() []string {
+	seen := map[string]bool{}
+	names := []string{}
+	for _, name := range AssetNames() {
+		db := strings.SplitN(name, ".", 2)[0]
+		if strings.HasPrefix(db, "xo_") || seen[db] {
+			continue
+		}
+		seen[db] = true
+		names = append(names, db)
+	}
+
+	return names
+}
@@ -0,0 +1,50 @@
+package templates
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_Asset(t *testing.T) {
+	files, err := filepath.Glob("*.tpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range files {
+		// symlinks in the source tree must be resolved by links
+		fi, err := os.Lstat(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fi.Mode()&os.ModeSymlink != 0 {
+			target, err := os.Readlink(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if links[name] != target {
+				t.Errorf("%s: expected link to %s, got: %q", name, target, links[name])
+			}
+		} else if _, ok := links[name]; ok {
+			t.Errorf("%s: expected no link for a regular file", name)
+		}
+
+		exp, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf, err := Asset(name)
+		if err != nil {
+			t.Errorf("%s: expected template to be embedded, got: %v", name, err)
+		} else if !bytes.Equal(buf, exp) {
+			t.Errorf("%s: expected embedded template to match the source tree", name)
+		}
+	}
+
+	if n := len(AssetNames()); n != len(files) {
+		t.Errorf("expected %d templates, got: %d", len(files), n)
+	}
+}
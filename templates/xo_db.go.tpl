@@ -1,15 +1,33 @@
 // XODB is the common interface for database operations that can be used with
-// types from schema '{{ schema .Schema }}'.
+// types from {{ schemadesc }}.
 //
 // This should work with database/sql.DB and database/sql.Tx.
 type XODB interface {
+{{- if .Context }}
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+{{- else }}
 	Exec(string, ...interface{}) (sql.Result, error)
 	Query(string, ...interface{}) (*sql.Rows, error)
 	QueryRow(string, ...interface{}) *sql.Row
+{{- end }}
 }
 
 // XOLog provides the log func used by generated queries.
 var XOLog = func(string, ...interface{}) { }
+{{- if or .CreatedColumns .UpdatedColumns .SoftDeleteColumn }}
+
+// XONow provides the time set by generated queries in the created, updated
+// and soft delete timestamp columns.
+var XONow = time.Now
+{{- end }}
+{{- if .VersionColumn }}
+
+// ErrStaleObject is the error returned by the generated Update, Delete and
+// Upsert when the row was changed or deleted since it was loaded.
+var ErrStaleObject = errors.New("stale object")
+{{- end }}
 
 // ScannerValuer is the common interface for types that implement both the
 // database/sql.Scanner and sql/driver.Valuer interfaces.
@@ -68,4 +86,111 @@ func (ss StringSlice) Value() (driver.Value, error) {
 
 // Slice is a slice of ScannerValuers.
 type Slice []ScannerValuer
+{{- if hastypes }}
+
+// XOCond is a condition of the queries built by the query builders (ie,
+// Authors), created by the columns of the types (ie, AuthorColumns.Name.Eq).
+type XOCond struct {
+	// parts are the SQL of the condition around the placeholders of its args.
+	parts []string
+	args  []interface{}
+}
+
+// XOAnd returns the condition of all of the conds being true.
+func XOAnd(conds ...XOCond) XOCond {
+	if len(conds) == 0 {
+		return XOCond{parts: []string{`1 = 1`}}
+	}
+	return xoJoin(` AND `, conds)
+}
+
+// XOOr returns the condition of any of the conds being true.
+func XOOr(conds ...XOCond) XOCond {
+	if len(conds) == 0 {
+		return XOCond{parts: []string{`1 = 0`}}
+	}
+	return xoJoin(` OR `, conds)
+}
 
+// xoJoin joins the conds with the sep in parentheses.
+func xoJoin(sep string, conds []XOCond) XOCond {
+	c := XOCond{parts: []string{`(`}}
+	for i, cond := range conds {
+		if i != 0 {
+			c.parts[len(c.parts)-1] += sep
+		}
+		c.parts[len(c.parts)-1] += cond.parts[0]
+		c.parts = append(c.parts, cond.parts[1:]...)
+		c.args = append(c.args, cond.args...)
+	}
+	c.parts[len(c.parts)-1] += `)`
+	return c
+}
+
+// render returns the SQL of the condition, numbering the placeholders of its
+// args after the n preceding args of the query.
+func (c XOCond) render(n int) string {
+	s := c.parts[0]
+	for i, p := range c.parts[1:] {
+		s += xoParam(n+i+1) + p
+	}
+	return s
+}
+
+// xoParam returns the placeholder of the 1-based param n of a query.
+func xoParam(n int) string {
+	return {{ paramexpr "n" }}
+}
+
+// XOOrder is an order of the queries built by the query builders, created by
+// the columns of the types (ie, AuthorColumns.Name.Desc).
+type XOOrder struct {
+	expr string
+}
+
+// XOColumn is a column of a table, embedded in the typed columns of the types
+// (ie, AuthorColumns.Name).
+type XOColumn struct {
+	name string
+}
+
+// cond returns the condition of the column compared to v with the op.
+func (c XOColumn) cond(op string, v interface{}) XOCond {
+	return XOCond{parts: []string{c.name + op, ``}, args: []interface{}{v}}
+}
+
+// in returns the condition of the column being any of the vs.
+func (c XOColumn) in(vs []interface{}) XOCond {
+	if len(vs) == 0 {
+		return XOCond{parts: []string{`1 = 0`}}
+	}
+
+	parts := []string{c.name + ` IN (`}
+	for i := 1; i < len(vs); i++ {
+		parts = append(parts, `, `)
+	}
+	parts = append(parts, `)`)
+
+	return XOCond{parts: parts, args: vs}
+}
+
+// IsNull returns the condition of the column being null.
+func (c XOColumn) IsNull() XOCond {
+	return XOCond{parts: []string{c.name + ` IS NULL`}}
+}
+
+// IsNotNull returns the condition of the column not being null.
+func (c XOColumn) IsNotNull() XOCond {
+	return XOCond{parts: []string{c.name + ` IS NOT NULL`}}
+}
+
+// Asc returns the ascending order of the column.
+func (c XOColumn) Asc() XOOrder {
+	return XOOrder{expr: c.name + ` ASC`}
+}
+
+// Desc returns the descending order of the column.
+func (c XOColumn) Desc() XOOrder {
+	return XOOrder{expr: c.name + ` DESC`}
+}
+{{- end }}
@@ -1,4 +1,4 @@
-// Package {{ .Package }} contains the types for schema '{{ schema .Schema }}'.
+// Package {{ .Package }} contains the types for {{ schemadesc }}.
 package {{ .Package }}
 
 // Code generated by xo. DO NOT EDIT.
@@ -1,925 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// templates/mssql.foreignkey.go.tpl
-// templates/mssql.index.go.tpl
-// templates/mssql.query.go.tpl
-// templates/mssql.querytype.go.tpl
-// templates/mssql.type.go.tpl
-// templates/mysql.enum.go.tpl
-// templates/mysql.foreignkey.go.tpl
-// templates/mysql.index.go.tpl
-// templates/mysql.proc.go.tpl
-// templates/mysql.query.go.tpl
-// templates/mysql.querytype.go.tpl
-// templates/mysql.type.go.tpl
-// templates/oracle.foreignkey.go.tpl
-// templates/oracle.index.go.tpl
-// templates/oracle.query.go.tpl
-// templates/oracle.querytype.go.tpl
-// templates/oracle.type.go.tpl
-// templates/postgres.enum.go.tpl
-// templates/postgres.foreignkey.go.tpl
-// templates/postgres.index.go.tpl
-// templates/postgres.proc.go.tpl
-// templates/postgres.query.go.tpl
-// templates/postgres.querytype.go.tpl
-// templates/postgres.type.go.tpl
-// templates/sqlite3.foreignkey.go.tpl
-// templates/sqlite3.index.go.tpl
-// templates/sqlite3.query.go.tpl
-// templates/sqlite3.querytype.go.tpl
-// templates/sqlite3.type.go.tpl
-// templates/xo_db.go.tpl
-// templates/xo_package.go.tpl
-// DO NOT EDIT!
-
-package tplbin
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(strings.NewReader(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _mssqlForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\x06\xf2\x1f\xde\x41\xd8\x64\xd9\xed\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x24\xa9\x5a\x42\xfe\xbb\x24\xed\x76\xeb\xd2\x43\x20\x7c\xf3\x66\xe6\xbd\xf1\x7e\x8b\x6b\x5b\x6b\xe3\x70\x73\x0b\x91\x7e\x2a\x6f\x09\xd9\x5b\xff\x45\xd9\x73\xde\x92\xc4\x36\x04\xce\x76\x3b\x78\x8f\x44\x10\x02\x0c\xb9\xce\x28\x0b\x57\x53\xe2\xaf\x54\x4d\x1d\xb1\x9e\x5b\xab\x8b\x26\x77\x54\xe2\xa7\x71\xf5\xa4\x9b\x8b\x56\x36\xa1\x87\x86\x8e\xe5\xd4\x28\xce\xe8\x4e\x1f\xe3\xeb\x5a\x35\x16\x65\x16\x7d\x24\x2f\x8f\xa4\xc8\xa4\xf1\x95\xd1\x2d\x2a\x6d\xa8\xf9\x50\xf8\xa4\x1e\xab\x34\x61\x00\x4f\xd4\xcf\xbe\xa7\xbd\x19\x67\x55\xa7\x8a\xb4\x6b\x4c\x1f\x02\xd6\x97\xfe\xe4\x3c\xb1\x28\x0f\x78\x7f\xb9\xdf\x4b\x88\xf5\x42\xe0\x0d\xc8\x18\x6d\x24\x3c\x67\x57\xc3\x71\x96\xee\xb2\xef\x47\xf8\x2f\xb4\x28\x0f\x9b\xa8\x2e\xb4\xfa\xa6\x5f\x77\xf2\x34\x9c\xe1\x2c\x8f\x96\x38\x0b\x9c\x71\xf6\x17\x00\x00\xff\xff\x59\x1b\xe5\x5b\xb8\x01\x00\x00"
-
-func mssqlForeignkeyGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_mssqlForeignkeyGoTpl,
-		"mssql.foreignkey.go.tpl",
-	)
-}
-
-func mssqlForeignkeyGoTpl() (*asset, error) {
-	bytes, err := mssqlForeignkeyGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mssql.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mssqlIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x61\x4f\xdb\x30\x10\xfd\x9c\x48\xf9\x0f\x37\x6b\x82\x74\x2b\xe9\x77\xa4\x7e\xd8\xa0\x6c\xd3\x18\x6c\xc0\x34\x24\x84\x56\x37\xb9\x40\xa4\xd4\x6e\x6c\x07\xa8\x2c\xff\xf7\xe9\xec\x34\x94\x52\x6d\xb0\x2f\xc6\xb1\x7d\xef\xde\xbd\xf7\xa8\xb5\x7b\xf0\x56\xdf\x4a\x65\x60\x7f\x0c\xa9\xdf\x09\x3e\x47\xc8\x2e\x96\x0b\xcc\x4e\x68\xcb\x50\x29\x06\x4c\x37\xb5\x36\xb4\x29\x66\x0c\x58\xc3\x80\x29\xd4\x0c\xd8\xe5\xe9\xb1\xbc\x61\x90\x1d\x55\x58\x17\x7a\x00\x7b\xce\x25\xb1\xc7\x35\x7c\x56\x63\xc0\xcd\x6f\x71\xce\x21\x3b\xef\xfe\x7a\xf0\x0b\xba\x0e\x2b\xf5\xe9\x2a\x47\x23\xb0\x16\xb2\xa3\x56\xe4\xbe\xbb\x73\xa0\xd0\xa8\x0a\xef\x50\x03\x07\x25\xef\xa1\x54\x72\x0e\xbb\xd6\xae\x3a\x38\xb7\x0b\x9c\x2e\xa9\xf0\x91\xb7\x73\x19\xc1\x79\xc8\x4f\x28\x50\x71\x83\x45\x28\xae\x44\x81\x0f\x1e\x22\xfb\x42\xdb\xb0\x76\x55\xbb\x59\x12\x97\xad\xc8\x37\x79\xa4\xc5\x0c\x2e\x4f\x0f\x3f\x5a\x0b\x37\x72\xc1\x15\x9f\xd7\x95\x36\xab\xc1\xc1\xa8\x16\xc3\xe2\xdc\x00\x52\x6b\xa1\x2a\x41\x48\xd3\xb7\xd0\x3f\x45\xd5\xf8\xeb\xab\x6b\x6b\x01\x45\x01\xce\xbd\xdb\xe4\x3c\x04\x54\x4a\xaa\x01\xd8\x24\x8e\xee\xb8\xa2\xcf\x70\x94\xc4\x49\x1c\x8d\x46\xa0\x9b\x1a\x9a\x16\xd5\x32\x89\xa3\x5c\x0a\x6d\x20\x58\x03\x63\x98\x9e\x4f\x8e\x27\x07\x17\x30\x85\xf7\x49\x1c\x45\x53\x6b\x21\x97\x35\x19\xaa\xbb\x2e\x1d\x59\xe7\xfa\x37\x47\x67\xa7\xdf\x60\x5d\xcc\xfe\xe6\xd7\xe7\xc9\xd9\x04\xd6\x30\x7c\xd7\x7e\x60\x06\x1f\x4e\x0e\x81\x81\x73\xd3\x15\x35\xd5\x8a\x9e\x9a\xcf\x45\x1a\xa8\xfd\x4d\xb2\x92\xd7\xda\x6b\x16\x52\x53\x95\x5b\x04\x4b\xe2\x88\x18\x86\xa0\x3a\x47\x99\xda\xd4\xcd\xfa\x37\xa1\xde\x9f\x7f\x57\xd5\x9c\xab\xe5\x57\x5c\x06\x80\xe8\x37\x3e\x54\xda\xe8\x7d\xdf\x76\x18\x20\xc9\x04\x1f\xbb\xc8\xf9\x19\x48\xec\x31\x14\xb3\xec\x07\x4d\x71\x26\xef\x5f\x33\x40\x76\x9e\x73\x41\xc6\x97\x74\xbb\x45\xf5\x74\xa1\x2a\x61\x80\xed\xb0\x6e\x96\x41\x98\x3b\xaa\x4a\x6f\xf3\x9b\x31\x88\xaa\xf6\xce\x47\x0a\x4d\xab\x04\x7d\xfb\x44\xf4\x0c\xbb\xf3\x9d\x75\x3d\x86\xf4\x2c\xc8\x87\x81\x4b\x12\x47\x8d\xaf\x23\xa9\x56\xe3\xbc\xce\x8c\x17\x93\x8a\x0a\x2c\x51\x41\x93\x1d\xd4\x52\x63\x3a\x58\x85\xa1\x96\xbc\x00\x85\xba\xad\x8d\xf6\xc4\x35\x91\xb9\xba\x7e\x16\x79\x4b\x20\xa5\x24\x88\x13\x7c\x30\x69\xc8\xfe\x8b\x1c\xff\x87\xe5\xcf\x3d\x7f\x6a\x7a\xa7\xa9\xff\xaf\xca\xb9\xa0\x6d\x48\x40\xf3\xff\x5e\x6e\xd3\x6d\x8b\x70\xab\xd6\x24\xcb\x18\xf8\x62\x81\xa2\x48\x15\xea\xe1\x53\x6b\x07\x9b\xc6\xfb\x27\x8f\x76\xfb\x1f\x91\x24\xf6\x4f\xfe\x04\x00\x00\xff\xff\xf2\x9f\x01\xf4\xcd\x05\x00\x00"
-
-func mssqlIndexGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_mssqlIndexGoTpl,
-		"mssql.index.go.tpl",
-	)
-}
-
-func mssqlIndexGoTpl() (*asset, error) {
-	bytes, err := mssqlIndexGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mssql.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mssqlQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x6a\xdc\x3c\x10\xbd\xb6\xc1\xef\x30\x9f\x58\x82\xfd\xd5\xf1\xde\x07\x7c\xd3\x94\x42\xa1\x64\xfb\x77\x11\x08\x81\x6a\xd7\xf2\xd6\xa0\x95\xd6\x92\x9c\x66\x11\x7a\xf7\x32\x92\x7f\x37\x1b\x4a\x73\x27\x0f\x47\x47\xe7\xcc\x99\xb1\xb5\xd7\xb0\xd2\xbf\xa4\x32\x70\x53\x42\xea\x4f\x82\x1e\x18\x14\x3f\x4e\x47\x56\xdc\xe1\x91\x30\xa5\x08\x10\xdd\x72\x6d\xf0\x50\x6d\x09\x90\x96\x00\x51\x4c\x13\x20\xf7\x9b\xcf\x72\x4f\xa0\xf8\xda\x31\x75\xfa\x42\x15\x3d\xe8\x0c\xae\x9d\x4b\x62\x4f\xde\x62\xf9\x56\x1e\x0e\x4c\x18\x8d\x8f\x04\xe0\x58\x19\x91\x4d\x0d\x45\x5f\x0d\xc5\xf5\x1a\xac\x9d\x6a\x03\x8e\x71\xcd\x16\x00\x2f\xd2\x39\x50\x9d\xd0\x40\x61\xd7\x69\x23\x0f\xe0\xdf\xcd\x41\x31\xd3\x29\xd1\x88\x3d\x28\xa6\x3b\x6e\x34\x50\xed\x6f\x4d\xfe\x9c\x2b\x7a\x66\x51\xf9\x57\xea\x4e\xec\x16\xcc\x69\xb5\x85\xfb\xcd\x87\xf7\xd6\x82\xa2\x62\xcf\x16\x66\xc1\xb9\x7c\x81\x1e\xd8\xc1\x39\x6b\x7b\xd2\x0c\x52\x6b\xd1\xa2\x90\x06\x8a\x8d\xe0\xa7\x8d\x40\xc0\xc3\xe3\x08\xf9\xff\x5c\x55\x0e\x4c\x29\xa9\x32\xb0\x49\x1c\x3d\x51\x85\x9f\xa1\x94\xc4\x49\x1c\xad\xd7\xa0\x5b\x1e\x7c\x26\x71\x14\xe8\x8b\x4f\xc2\x30\x75\x94\x9c\x1a\xa4\x78\xa2\x0a\xf9\xb1\x63\xce\xed\xa4\xd0\x66\x7c\x0e\x42\x9e\x50\xc2\xe8\x6a\xd5\xe4\xb0\xe2\x53\x46\xc1\x40\x53\xc3\xaa\xc1\x0b\xef\xc6\xbb\xa1\x9a\x36\xa2\x62\xcf\xe7\x09\xaf\x9a\x0c\xc1\x21\x9b\x57\x10\xf3\xce\xcc\x5e\xf0\x2e\xb0\x7a\xed\xdc\x4f\x6b\x51\x4b\x38\x0c\xc1\x04\xd7\xaa\x13\xa3\x6b\x3f\x7c\x69\xb0\xf2\x5a\x3a\xb3\xc6\x2f\xbb\xb3\x88\x6d\x2e\xa8\xcf\x6c\x9a\xcb\x29\xb0\x3e\x0a\x54\x17\xd6\x66\x16\xf8\x40\x95\xc4\x11\x46\x55\x42\xb5\x0d\x52\xbe\xc9\xdf\x7f\xd1\x78\x59\x4a\x56\x7c\xdf\x51\x81\x93\x53\x37\x8c\x57\xb8\x98\xba\x7f\xea\x23\x16\x34\xa4\x47\xd5\x08\x03\xe4\x8a\xf4\x7a\xb2\x20\x3c\x6a\x6a\x3f\x2e\xff\x95\x20\x1a\xee\x27\x28\x0a\xab\x80\xdf\x7e\xb2\x92\x38\x0a\x2d\xed\xeb\x57\x73\x4f\x39\xc2\x66\xfb\xe6\x4d\xb5\xfe\x1e\xce\xc7\x60\xec\x6d\xae\xfe\x41\x5e\x54\xb1\x9a\x29\x68\x8b\x5b\x2e\x35\x4b\xb3\x61\x06\xb8\xa4\xd5\xb0\xd1\xde\x82\xff\xb5\x3c\x3c\xbe\x58\x22\x8b\x24\xb5\x44\x8a\x3b\xf6\x6c\xd2\xb0\x4d\xd1\x22\xbf\x9b\xf2\x45\x84\x36\xb4\xc6\x2f\xd9\x8e\x0a\x3c\x86\x48\xdb\xb7\x47\x72\xc9\xf4\x05\xd7\x7d\x2a\xde\x53\x09\xf4\x78\x64\xa2\x4a\x15\xd3\xf9\x32\xa1\xec\x3c\x3f\x0f\x99\x52\xeb\x57\xc6\x43\xfe\x04\x00\x00\xff\xff\x9b\xab\xcf\x10\xe6\x05\x00\x00"
-
-func mssqlQueryGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_mssqlQueryGoTpl,
-		"mssql.query.go.tpl",
-	)
-}
-
-func mssqlQueryGoTpl() (*asset, error) {
-	bytes, err := mssqlQueryGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mssql.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mssqlQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8f\xcf\x4a\xc4\x30\x10\xc6\xcf\x5b\xe8\x3b\x7c\x2c\xc2\xea\x61\xb3\xf7\x05\x4f\x82\x47\x2f\xf6\x01\x1a\xdb\xa9\x56\xf2\xa7\x24\x29\x22\xc3\xbc\xbb\xa4\x69\xd5\xee\x21\x13\x98\xf9\xcd\x8f\x6f\x98\xcf\xb8\x4b\xfa\xcd\x10\xae\x8f\xb8\x8f\xdd\x07\x59\x0d\xf5\xba\xfe\x4d\x9e\x94\xfa\xa2\x2d\x3d\xe0\x2c\x52\x57\x79\x69\x1c\xa0\x9e\xbc\xb5\xe4\x52\x69\x5e\x2e\x60\xfe\xeb\x6d\x1c\x99\x48\x3b\x20\x7b\x20\x82\x40\x53\xa0\x48\x2e\x45\x68\x04\xff\x85\x21\x78\x8b\x13\xf3\x96\x47\xe4\xa4\x56\x87\xeb\x17\x5f\xfa\x9e\x68\xe7\x88\x29\xcc\x5d\x02\x17\x2c\x68\xf7\x4e\x50\xcf\x23\x99\x3e\x2e\x0b\x87\xff\x30\x33\x02\x2d\x0a\xd5\xe4\x2a\x82\xf6\x33\x7a\x77\x3d\x96\xdc\x26\xbf\xd9\xba\x95\x3f\xb6\xf8\x3d\xe9\x66\x74\xd8\xc7\x92\xba\xaa\xab\x9f\x00\x00\x00\xff\xff\x8a\xe5\x0f\x67\x49\x01\x00\x00"
-
-func mssqlQuerytypeGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_mssqlQuerytypeGoTpl,
-		"mssql.querytype.go.tpl",
-	)
-}
-
-func mssqlQuerytypeGoTpl() (*asset, error) {
-	bytes, err := mssqlQuerytypeGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mssql.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mssqlTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x6d\x6f\xda\xc8\x13\x7f\x6d\x24\xbe\xc3\xd4\xaa\xfe\xb5\xfb\xa7\x46\xf7\x36\x52\x5e\xb4\x09\xbd\x46\x4d\x93\x5e\xa0\xd7\x4a\xa7\x53\x59\xf0\x38\xec\xc5\xde\x4d\x76\xd7\x14\x84\xfc\xdd\x4f\xfb\x60\x63\x83\x93\x98\x26\x3d\xa4\xda\x8d\x3d\x3b\xf3\x9b\xa7\xdf\x0c\x6c\x36\x6f\xe0\xa5\x5c\x70\xa1\xe0\xe8\x18\x02\xf3\x3f\x46\x32\x84\xe8\x42\x5f\x7d\x14\xc2\x07\x5f\xa0\xf4\xc1\x97\x77\xa9\x54\xfa\xcf\x78\xe6\x83\xff\xed\xf2\x9c\x5f\xfb\x21\xbc\x29\x8a\x7e\xcf\xa8\x51\x64\x96\xa2\x55\x33\x5f\x60\x46\x20\x1a\xbb\xfb\x44\xbf\xb1\x57\xad\xb6\x76\x88\x26\x10\x9d\xf0\x2c\x43\xa6\xec\xc3\xe1\x10\x36\x9b\xed\xb3\x52\x0e\x53\x89\x0d\x01\x03\xaf\x28\x40\xe0\xad\x40\x89\x4c\x49\x20\x20\xf8\x0f\x48\x04\xcf\xe0\xd5\x66\x53\xe2\x29\x8a\x57\x91\xd3\xc1\x62\xa3\x4f\xad\x6f\xb1\xa1\x43\x2a\x91\xcf\x15\x6c\xac\x98\x20\xec\x1a\x21\x7a\x4f\x31\x8d\xa5\x39\xe0\xd5\x85\x37\x1b\x10\x68\x54\x44\x13\x7d\x2d\x0a\x98\xfe\x23\x39\x3b\xf2\x2d\xee\x54\xff\xcb\x33\xe6\xe4\xfd\x29\x54\x2e\xed\xbc\x6a\xa2\x2a\xa3\xf1\x59\xd0\x8c\x88\xf5\x47\x5c\x9b\xc7\xfd\x9e\x37\x1c\xc2\x8a\x43\x62\xf0\xf4\x7b\xde\x77\x5c\x51\xa9\xe4\x00\xbe\xc7\x98\xa2\xc2\x18\x66\x9c\xa7\x5a\x41\x43\x57\xe9\x06\x17\x48\xaf\xd9\x47\x5c\x6f\x7d\x49\xec\x33\xe3\xa1\xc1\x62\x9d\x2d\x5d\x7c\xff\x11\x5e\x6b\xc0\x57\x98\x68\x0f\x2b\xcf\xb7\x6e\x3a\x05\xa7\xef\xea\xa7\xf7\x5d\x87\x78\x76\x88\xf8\xb4\x19\x10\xe3\x7c\x19\x95\xf1\x5d\xba\xb2\xf8\x87\x43\x18\x3e\xd7\xc7\xaa\x2b\x3f\x1f\x30\xbd\x45\x01\x49\xce\xe6\x8a\x72\x26\x35\x6e\xb8\xcb\x51\xac\x29\xbb\x86\x5c\xea\xab\x5a\x20\x48\x8d\x25\xa5\x33\x41\xc4\xfa\xd9\x01\xf5\x7b\x9e\x06\x00\x7f\x68\xbb\xb5\xba\x0b\xee\x8c\xdd\xc8\x3c\x47\x31\xb0\xc0\x74\xe9\x52\x76\x3d\x00\x22\xae\x25\x44\x51\x44\x99\x42\x91\x90\x39\x6e\x8a\x10\x82\xd7\x35\x05\x03\x40\x21\xb8\x08\x75\x99\x7b\xde\x92\x08\x88\x51\x2a\xd8\x6c\xa2\xaa\x1c\x3d\x0f\x85\xd0\x0d\x6c\x2c\xfd\x8e\x2a\xb8\x1b\xc0\xff\xb4\x98\x33\x67\xed\x44\x51\x14\x6a\x61\x81\x2a\x17\xac\x14\x40\x21\xfa\x3d\xaf\xd8\xf7\x60\xce\xd9\x12\x85\xba\xd8\xb2\x4a\x51\xc8\x9f\x72\xe7\xaf\xbf\x3b\x38\x64\x84\xee\xf3\x69\x8c\x29\xce\xbb\xb9\xf5\x88\x57\xa5\x85\xaf\x54\x2d\x4e\xd4\x2a\x98\xab\x95\xf6\x54\xe1\x4a\x45\x27\xf6\x3e\x80\xa6\x93\xdb\xc7\xff\x41\xea\x9c\x31\x8d\x6b\x00\xbf\x2c\x8d\xbf\xca\xfb\xe7\xca\xf4\xc1\x51\xd8\x09\x42\x9d\x8f\x0c\xc3\xb6\x30\xf4\x70\x08\x23\xc3\xc8\x10\xa3\x42\x91\x51\x86\x52\xcb\x69\xaa\xa8\x0f\x0e\x4b\xdb\x40\x99\x79\x13\x13\x45\x66\x44\x62\xd4\xef\x99\x30\x07\x7a\x62\xd9\x41\x5c\x14\x50\xf7\x3e\x74\xea\x83\xd0\x10\xbd\x09\x82\x43\x5b\x3f\x13\xb9\xb9\xe0\x88\x73\x38\x84\x53\x37\x1e\x6e\x05\x5f\xd2\x58\x83\x62\x09\x17\x19\xd1\xe4\xd6\x06\x70\x41\x24\xcc\x10\x75\x10\xec\x41\x33\x4a\x0f\x05\xeb\xac\x3e\x8a\xd6\x19\xd9\xc2\x3d\x63\x12\x85\x02\x6a\x6e\x72\x0f\x9d\xe2\x07\x43\xb1\x1a\x83\x78\x06\xdf\x2e\x4f\xdf\x85\xb6\x8a\x0c\x22\x5d\x43\xba\x5a\xcc\x93\x72\xc8\xd2\x04\x48\x2a\x90\xc4\x6b\x9b\xab\x01\xcc\x08\x4d\xfb\x3d\x8f\x26\xad\x91\xb6\xe5\xe8\x9c\x33\x9a\x64\x74\x81\x3f\x02\xdf\xba\x00\x09\xa1\x29\xc6\x47\x4d\xa5\xd2\x0f\xcb\xfe\x72\xe5\x64\x97\xa3\x4f\x84\xe5\x24\xfd\x7c\x03\xd5\x94\x93\x77\xa9\x0b\x46\x59\xaf\xb7\xb6\xf0\xe0\x06\xd7\x90\xe5\x52\xc1\x0c\xcb\xe4\xc6\xfd\x9e\x37\xe7\x4c\x2a\xb0\x5b\x1a\x1c\xc3\xf4\xec\x62\x3c\xba\x9a\xc0\xd9\xc5\xe4\x12\xea\xfb\x10\x04\x53\xf8\xbf\x86\x3e\x35\x6d\x9d\xea\x7d\x4f\xd6\x16\x9e\xf2\x6d\x08\x7f\xbe\x3d\xff\x32\x1a\xef\xca\x2f\x49\xda\x2a\x3e\x2d\x03\x29\x72\x66\x21\xf7\x7b\x9e\x59\x13\x03\x0b\x6a\xa0\x61\x98\x3d\xa6\x69\xb2\x8a\xac\x0e\xcd\x77\xd3\x7e\x70\x0c\xf1\x2c\x1a\xad\x70\x7e\xc8\x59\x9a\x98\xb3\x2f\x8e\x81\xd1\x74\x37\x3d\x15\xad\xe9\xd8\xa2\xb2\xe9\x40\x36\x47\xbb\x14\xed\xa7\xf7\x18\x94\xc8\xd1\x2e\x56\x7a\xfd\xec\x96\x98\x32\x21\x30\x5b\x03\x8d\x91\x29\xaa\xd6\xcf\x96\x9c\x1a\xf7\x94\x65\x7e\x50\xb6\x1e\x3a\xff\xd4\xf4\xb5\xe8\x0e\x4d\xf3\x4b\x9b\xd1\xa3\x03\x53\x7a\x8f\xbe\xce\x39\x16\xa8\x04\xc5\x25\x02\xd5\xcd\x41\xe3\x0a\x85\x40\x19\x9d\x13\xa9\x2c\x3d\x9c\xc5\xc1\xc1\xa5\x53\xcf\x37\x61\xf1\xfd\xa5\xa4\xe9\x68\xdf\x0d\x38\x86\x9d\x17\xee\x9b\x44\x40\xe3\xb0\x4b\x35\x56\x13\xa9\xc4\xc8\x34\x4f\xd5\x48\x85\x21\x04\xdd\x23\x1b\x82\xef\x57\xd5\xfd\xe5\x36\x26\x0a\x21\x37\xb7\x7d\x1a\xde\x1f\x5f\xde\xa3\x3c\x6c\x55\xb6\xf1\x70\x0b\x11\x3b\x26\x8e\x39\x4a\xf6\x4a\xed\x30\xb1\x4e\xd3\x8b\xfb\xb9\xb8\x8d\x8c\xad\x23\x15\x19\x6b\xbd\xc0\xb8\x53\x6c\xc8\xd8\xe5\xb6\x34\x6c\xe7\x52\xc3\x64\xeb\xe4\xea\x6c\x32\x23\xe2\x46\x4f\x53\x2e\xac\x72\xca\xd9\xae\x5d\x4d\x29\x65\xcb\xed\x51\xc5\x97\xcf\xa7\x6f\x27\xa3\x26\x4b\x8c\x47\x13\x70\x9d\xdb\x60\x0a\xbb\x5c\x95\xf9\xf6\x07\xe0\x3f\xd0\xf3\xde\x14\xbe\x7e\x18\x5d\x19\xd5\x4e\x41\x43\xfa\x84\xa7\xb6\x5a\x5f\x5a\x81\x39\xcf\x99\x7a\x90\x4b\x2a\x87\xea\x1c\xf2\x44\x12\x19\x40\x87\x96\x32\xf1\xfc\xb9\xd1\xf1\x24\x93\xed\x1c\x31\x26\x4b\x04\x49\x96\x2d\xfd\xb3\xbf\xc6\x3c\xde\x3f\x5a\x5d\x6b\xf7\xec\x96\x66\xb5\x28\x36\x4a\xb3\x21\x52\xf5\x62\xbd\x02\xdb\x04\xab\xe5\x29\x2c\x57\xe1\xe6\x14\x74\x3c\x21\x15\x51\x98\x99\xdf\x5f\x78\x46\x95\xee\x8b\x38\x47\xed\x66\x4a\xe6\x37\xc0\x13\xf7\xc3\x05\x70\xb5\x40\x01\x6a\x41\x58\x9d\x3e\x77\xf8\xac\xda\x5a\x5d\x1b\xee\xc7\xef\x09\x3b\xe9\x21\x8b\x60\x3b\xfd\x3c\xcc\x3e\x75\x2e\xde\xd6\x42\x0b\xa5\x3c\xcc\x28\xed\x6a\xea\x0c\xb1\x4b\x10\xa7\xa3\xf3\xd1\x64\x04\xef\xaf\x2e\x3f\x35\x59\xa2\x63\x77\xff\xd6\x6d\xf4\x77\x69\x88\x87\x5a\xb0\xcb\xf9\x83\xc6\x70\xf5\x15\xc2\x6b\x0f\x67\x39\x32\xf7\xe7\x64\xf3\x7b\xdd\xbf\x01\x00\x00\xff\xff\x33\x43\x07\xdb\x05\x15\x00\x00"
-
-func mssqlTypeGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_mssqlTypeGoTpl,
-		"mssql.type.go.tpl",
-	)
-}
-
-func mssqlTypeGoTpl() (*asset, error) {
-	bytes, err := mssqlTypeGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mssql.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mysqlEnumGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\xc1\x6a\xdc\x30\x10\x3d\xdb\xe0\x7f\x98\x8a\x42\xec\x90\x78\xe9\xa5\x87\xc0\x9e\x4a\x8f\xcd\xa1\x69\x73\x29\x3d\x68\xbd\xa3\xac\x88\x2d\x37\x92\xbc\x49\x30\xfa\xf7\xa2\x91\xdc\x4a\xdb\x0d\x6d\x0a\x7b\x59\xcc\x68\xf4\xf4\xe6\xbd\x37\xec\x3c\x5f\xc2\x5b\xfb\xfc\x03\xe1\x6a\x0d\xed\x35\x1f\x10\x2e\x9d\xab\x4a\xaa\x9b\xdd\xa8\xad\x3f\xa8\xe9\x4b\xf9\xd3\xd0\xcc\x50\x4d\xc3\x2d\xef\x19\x30\x8b\x4f\x96\x01\xdb\x4c\x82\x01\x1b\xef\x19\x30\xa3\x3b\xd6\x24\x30\x1a\xf7\xa8\x0d\x7a\x70\x43\xcf\x7c\x0e\x85\x0f\xa3\x32\x36\x54\xa9\x79\xb5\x82\x79\x8e\x0f\x38\x07\xd2\x80\xdd\x21\x9c\xcd\x33\xb4\x1f\xd5\x34\xd0\x0f\x31\x74\xee\x0c\x3c\x01\xa0\x56\xa1\xc7\x01\x4c\xb7\xc3\x81\x87\xe6\x9b\xf0\xed\xdb\xda\xaa\xa4\x9e\x14\x77\x92\xca\xbe\x7b\x5f\x95\x55\xd9\x79\x02\x50\x07\x9a\x9a\xab\x3b\x84\xf6\x96\xf7\x13\x1a\xf0\x7c\x8a\x40\x48\x8a\x83\x11\x9c\xf3\xaf\x44\x26\x09\xf2\x3c\x03\xf6\xe6\xcf\x62\xd2\x8a\x6a\x7b\x38\xda\x2d\xef\x69\x32\x7a\x98\x46\x4b\x6e\xb7\x55\x59\x9c\x86\xc2\x3a\x7d\xa6\x5e\x88\x90\x25\x0b\x93\xc6\x0b\x43\xfd\x64\x4f\xe3\x25\x5b\xad\xe0\xc6\x6a\xa9\xee\x40\xa3\x9d\xb4\x0a\x83\x98\x50\xda\xd3\xc5\x51\x50\x2d\x9f\x42\x4c\xaa\x03\xff\x4c\x0c\x95\x73\x69\x43\x13\x41\xeb\x66\x81\x9a\xab\xb2\xd8\x73\x0d\x31\x67\xb1\xec\x19\x14\xe6\x51\xda\x6e\x07\x39\xd6\x8b\x1e\x76\xdc\xe0\x69\x5c\xbc\xaa\xca\xa2\x58\xf8\xad\x81\x1d\x33\x93\xe5\x12\x16\x8e\x26\x08\xd2\x2d\xb3\x55\xa5\x8b\xca\x7e\xe2\xda\xec\x78\xff\x05\x9f\x2c\x0c\xe1\xdb\xe4\x2b\xa1\xec\x08\x7e\xe1\xfe\x41\xd1\x04\xac\x6e\xa0\xfe\xf6\x7d\xf3\x6c\xf1\x02\x50\xeb\x51\x37\xa4\x6f\xa4\x11\x4e\x32\xa4\x76\xb1\xa3\xb9\x00\x25\x13\x86\x5f\xd5\x90\x70\x9c\xd4\x51\x96\xb4\x90\x2f\xb3\x3c\xcf\x68\x66\x88\xb5\xbf\x15\x09\x35\x81\x2a\x31\x8d\x96\x87\x10\x50\x53\x53\xfc\xc5\xf2\xe3\x76\x90\x67\xe7\x19\x9d\xf5\x69\xd2\xf1\xcb\x78\x17\x3c\xdf\xa2\xe0\x53\x6f\x89\xc0\xe2\xbf\x9f\xcf\xb4\xd7\xf8\x58\x33\xa9\xf6\xbc\x97\xdb\x54\x47\xd6\x1c\x06\x26\xb3\x22\xcc\x64\xb8\x95\x46\x48\x8c\x7b\xf8\xd0\xaf\xb6\x5a\xee\x51\x07\x41\xb4\x4f\x0c\x6a\xc1\x3b\x04\xe1\xb5\x7c\xd5\x4e\x12\x84\xcf\x4e\x0a\x79\x2c\x41\x47\xa3\x73\x90\x9c\x9b\x8e\xab\x03\xb6\x5b\x6e\xf9\x86\x1b\x5c\x99\x87\xbe\xf5\xe7\xea\xf5\x84\xf3\x30\x79\x90\xda\xe8\xee\x37\xca\xec\xd2\x1c\x6d\x26\x71\x01\xe3\xbd\xff\x13\x32\xba\x6b\xe3\x52\x78\x9d\xa5\x80\x37\xe3\x3d\x35\x01\xc0\xff\x1a\x94\x09\x91\x27\x7b\x33\x89\x26\xaa\xf1\x33\x00\x00\xff\xff\xa9\x41\xf5\xd0\x74\x07\x00\x00"
-
-func mysqlEnumGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_mysqlEnumGoTpl,
-		"mysql.enum.go.tpl",
-	)
-}
-
-func mysqlEnumGoTpl() (*asset, error) {
-	bytes, err := mysqlEnumGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mysql.enum.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mysqlForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\x06\xf2\x1f\xde\x41\xd8\x64\xd9\xed\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x24\xa9\x5a\x42\xfe\xbb\x24\xed\x76\xeb\xd2\x43\x20\x7c\xf3\x66\xe6\xbd\xf1\x7e\x8b\x6b\x5b\x6b\xe3\x70\x73\x0b\x91\x7e\x2a\x6f\x09\xd9\x5b\xff\x45\xd9\x73\xde\x92\xc4\x36\x04\xce\x76\x3b\x78\x8f\x44\x10\x02\x0c\xb9\xce\x28\x0b\x57\x53\xe2\xaf\x54\x4d\x1d\xb1\x9e\x5b\xab\x8b\x26\x77\x54\xe2\xa7\x71\xf5\xa4\x9b\x8b\x56\x36\xa1\x87\x86\x8e\xe5\xd4\x28\xce\xe8\x4e\x1f\xe3\xeb\x5a\x35\x16\x65\x16\x7d\x24\x2f\x8f\xa4\xc8\xa4\xf1\x95\xd1\x2d\x2a\x6d\xa8\xf9\x50\xf8\xa4\x1e\xab\x34\x61\x00\x4f\xd4\xcf\xbe\xa7\xbd\x19\x67\x55\xa7\x8a\xb4\x6b\x4c\x1f\x02\xd6\x97\xfe\xe4\x3c\xb1\x28\x0f\x78\x7f\xb9\xdf\x4b\x88\xf5\x42\xe0\x0d\xc8\x18\x6d\x24\x3c\x67\x57\xc3\x71\x96\xee\xb2\xef\x47\xf8\x2f\xb4\x28\x0f\x9b\xa8\x2e\xb4\xfa\xa6\x5f\x77\xf2\x34\x9c\xe1\x2c\x8f\x96\x38\x0b\x9c\x71\xf6\x17\x00\x00\xff\xff\x59\x1b\xe5\x5b\xb8\x01\x00\x00"
-
-func mysqlForeignkeyGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_mysqlForeignkeyGoTpl,
-		"mysql.foreignkey.go.tpl",
-	)
-}
-
-func mysqlForeignkeyGoTpl() (*asset, error) {
-	bytes, err := mysqlForeignkeyGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mysql.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mysqlIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x61\x4f\xdb\x30\x10\xfd\x9c\x48\xf9\x0f\x37\x6b\x82\x74\x2b\xe9\x77\xa4\x7e\xd8\xa0\x6c\xd3\x18\x6c\xc0\x34\x24\x84\x56\x37\xb9\x40\xa4\xd4\x6e\x6c\x07\xa8\x2c\xff\xf7\xe9\xec\x34\x94\x52\x6d\xb0\x2f\xc6\xb1\x7d\xef\xde\xbd\xf7\xa8\xb5\x7b\xf0\x56\xdf\x4a\x65\x60\x7f\x0c\xa9\xdf\x09\x3e\x47\xc8\x2e\x96\x0b\xcc\x4e\x68\xcb\x50\x29\x06\x4c\x37\xb5\x36\xb4\x29\x66\x0c\x58\xc3\x80\x29\xd4\x0c\xd8\xe5\xe9\xb1\xbc\x61\x90\x1d\x55\x58\x17\x7a\x00\x7b\xce\x25\xb1\xc7\x35\x7c\x56\x63\xc0\xcd\x6f\x71\xce\x21\x3b\xef\xfe\x7a\xf0\x0b\xba\x0e\x2b\xf5\xe9\x2a\x47\x23\xb0\x16\xb2\xa3\x56\xe4\xbe\xbb\x73\xa0\xd0\xa8\x0a\xef\x50\x03\x07\x25\xef\xa1\x54\x72\x0e\xbb\xd6\xae\x3a\x38\xb7\x0b\x9c\x2e\xa9\xf0\x91\xb7\x73\x19\xc1\x79\xc8\x4f\x28\x50\x71\x83\x45\x28\xae\x44\x81\x0f\x1e\x22\xfb\x42\xdb\xb0\x76\x55\xbb\x59\x12\x97\xad\xc8\x37\x79\xa4\xc5\x0c\x2e\x4f\x0f\x3f\x5a\x0b\x37\x72\xc1\x15\x9f\xd7\x95\x36\xab\xc1\xc1\xa8\x16\xc3\xe2\xdc\x00\x52\x6b\xa1\x2a\x41\x48\xd3\xb7\xd0\x3f\x45\xd5\xf8\xeb\xab\x6b\x6b\x01\x45\x01\xce\xbd\xdb\xe4\x3c\x04\x54\x4a\xaa\x01\xd8\x24\x8e\xee\xb8\xa2\xcf\x70\x94\xc4\x49\x1c\x8d\x46\xa0\x9b\x1a\x9a\x16\xd5\x32\x89\xa3\x5c\x0a\x6d\x20\x58\x03\x63\x98\x9e\x4f\x8e\x27\x07\x17\x30\x85\xf7\x49\x1c\x45\x53\x6b\x21\x97\x35\x19\xaa\xbb\x2e\x1d\x59\xe7\xfa\x37\x47\x67\xa7\xdf\x60\x5d\xcc\xfe\xe6\xd7\xe7\xc9\xd9\x04\xd6\x30\x7c\xd7\x7e\x60\x06\x1f\x4e\x0e\x81\x81\x73\xd3\x15\x35\xd5\x8a\x9e\x9a\xcf\x45\x1a\xa8\xfd\x4d\xb2\x92\xd7\xda\x6b\x16\x52\x53\x95\x5b\x04\x4b\xe2\x88\x18\x86\xa0\x3a\x47\x99\xda\xd4\xcd\xfa\x37\xa1\xde\x9f\x7f\x57\xd5\x9c\xab\xe5\x57\x5c\x06\x80\xe8\x37\x3e\x54\xda\xe8\x7d\xdf\x76\x18\x20\xc9\x04\x1f\xbb\xc8\xf9\x19\x48\xec\x31\x14\xb3\xec\x07\x4d\x71\x26\xef\x5f\x33\x40\x76\x9e\x73\x41\xc6\x97\x74\xbb\x45\xf5\x74\xa1\x2a\x61\x80\xed\xb0\x6e\x96\x41\x98\x3b\xaa\x4a\x6f\xf3\x9b\x31\x88\xaa\xf6\xce\x47\x0a\x4d\xab\x04\x7d\xfb\x44\xf4\x0c\xbb\xf3\x9d\x75\x3d\x86\xf4\x2c\xc8\x87\x81\x4b\x12\x47\x8d\xaf\x23\xa9\x56\xe3\xbc\xce\x8c\x17\x93\x8a\x0a\x2c\x51\x41\x93\x1d\xd4\x52\x63\x3a\x58\x85\xa1\x96\xbc\x00\x85\xba\xad\x8d\xf6\xc4\x35\x91\xb9\xba\x7e\x16\x79\x4b\x20\xa5\x24\x88\x13\x7c\x30\x69\xc8\xfe\x8b\x1c\xff\x87\xe5\xcf\x3d\x7f\x6a\x7a\xa7\xa9\xff\xaf\xca\xb9\xa0\x6d\x48\x40\xf3\xff\x5e\x6e\xd3\x6d\x8b\x70\xab\xd6\x24\xcb\x18\xf8\x62\x81\xa2\x48\x15\xea\xe1\x53\x6b\x07\x9b\xc6\xfb\x27\x8f\x76\xfb\x1f\x91\x24\xf6\x4f\xfe\x04\x00\x00\xff\xff\xf2\x9f\x01\xf4\xcd\x05\x00\x00"
-
-func mysqlIndexGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_mysqlIndexGoTpl,
-		"mysql.index.go.tpl",
-	)
-}
-
-func mysqlIndexGoTpl() (*asset, error) {
-	bytes, err := mysqlIndexGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mysql.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mysqlProcGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\x41\x6b\xdc\x30\x10\x85\xcf\x16\xe8\x3f\x4c\x43\x69\xbc\x90\x78\xef\x85\xbd\xb4\xdd\x5b\x48\xd2\x6c\x28\xb9\x35\x8a\x3c\xde\x08\xb4\xd2\x66\x24\x6f\x1b\x84\xfe\x7b\x19\x59\x9b\x3a\xa1\x2d\xf4\x60\x19\xcb\xf3\xbe\xf7\xe6\xa5\x74\x0e\xef\x9d\x8f\xdf\xbc\xe9\xe1\xe3\x0a\x5a\x87\xd0\x5d\x93\xd7\xdd\x0d\xc6\x91\xdc\xed\xf3\x1e\xe1\xe4\xe0\x4d\x7f\xb2\x80\xf3\x9c\xa5\x28\x8a\x3d\x79\x5d\xc6\x83\x7e\xc4\x9d\x82\x6e\x53\xdf\x45\xca\xc7\xa5\xda\xe1\x4c\x61\x06\xf8\x23\x39\x92\xd9\x6e\x91\x4e\xa6\xc9\xe5\x12\x52\x82\x8e\xb5\x90\x33\x68\x65\x6d\x80\xf8\x88\x10\xa2\x27\xec\x81\x6d\xb1\x1f\x09\xe1\x34\xa5\x9a\x22\xe7\x96\x35\x4c\xbe\x56\xa4\x76\x01\x72\x5e\xc0\xf1\x6a\x6e\x96\xf3\x29\x78\x07\xfd\x43\x27\xc5\x30\x3a\x3d\xf7\x6a\xfb\x07\xb8\xbb\xfa\xf2\x29\x25\xd8\xfa\x3d\x73\xac\x09\x11\xba\x8a\x8c\x34\xe2\x74\x30\x9c\x0d\xcd\xf0\xbb\xb7\x9c\x53\x02\xc2\xc8\x26\xd5\xb0\xab\x8e\x67\x6c\x82\x8e\x67\x90\xc8\xd3\x02\x92\x14\xcd\x41\x11\x20\x95\xc7\x93\x14\x52\x34\xcb\x25\x84\x27\x0b\x4f\x23\xd2\xb3\x14\x8d\xf6\x2e\x44\xbe\x09\x91\x60\x05\xf7\x9b\xf5\xc5\xfa\xf3\x2d\xbc\x59\x5a\x7b\x7b\x50\x36\xbc\xa4\xcc\x79\x71\x7f\xa4\xd1\xe8\x8e\xb4\xda\xff\x2c\x6e\x8d\x40\x18\xe1\xaf\xc9\xa5\x68\xee\xae\x2e\xfc\xb6\x9d\x52\xfc\xab\x98\x41\xd9\x50\x9a\x91\xa2\xe1\xb5\x56\xdc\xf1\x57\x36\xbf\xf1\x3f\xfe\x47\xdf\x6d\xb4\x72\xed\x07\xc2\xc8\x28\x33\x94\x92\xde\xad\xc0\x19\x5b\x7a\x6b\xa8\x44\x9c\x42\x3b\x63\x5f\xe5\xbe\x34\xf6\xa5\x74\x24\x92\xa2\xc9\xa5\x8c\xaa\x21\x8c\x67\x0c\x9a\xfa\xc0\xc9\xf1\xcd\x92\xec\xfa\xbd\xa8\xa7\x1d\xd6\x3f\x51\xcf\x7e\x55\x52\x81\x17\x88\x9b\xca\xcc\xaf\x3f\xa5\xf8\x15\x00\x00\xff\xff\x7f\x4c\x90\x55\x56\x03\x00\x00"
-
-func mysqlProcGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_mysqlProcGoTpl,
-		"mysql.proc.go.tpl",
-	)
-}
-
-func mysqlProcGoTpl() (*asset, error) {
-	bytes, err := mysqlProcGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mysql.proc.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mysqlQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x6a\xdc\x3c\x10\xbd\xb6\xc1\xef\x30\x9f\x58\x82\xfd\xd5\xf1\xde\x07\x7c\xd3\x94\x42\xa1\x64\xfb\x77\x11\x08\x81\x6a\xd7\xf2\xd6\xa0\x95\xd6\x92\x9c\x66\x11\x7a\xf7\x32\x92\x7f\x37\x1b\x4a\x73\x27\x0f\x47\x47\xe7\xcc\x99\xb1\xb5\xd7\xb0\xd2\xbf\xa4\x32\x70\x53\x42\xea\x4f\x82\x1e\x18\x14\x3f\x4e\x47\x56\xdc\xe1\x91\x30\xa5\x08\x10\xdd\x72\x6d\xf0\x50\x6d\x09\x90\x96\x00\x51\x4c\x13\x20\xf7\x9b\xcf\x72\x4f\xa0\xf8\xda\x31\x75\xfa\x42\x15\x3d\xe8\x0c\xae\x9d\x4b\x62\x4f\xde\x62\xf9\x56\x1e\x0e\x4c\x18\x8d\x8f\x04\xe0\x58\x19\x91\x4d\x0d\x45\x5f\x0d\xc5\xf5\x1a\xac\x9d\x6a\x03\x8e\x71\xcd\x16\x00\x2f\xd2\x39\x50\x9d\xd0\x40\x61\xd7\x69\x23\x0f\xe0\xdf\xcd\x41\x31\xd3\x29\xd1\x88\x3d\x28\xa6\x3b\x6e\x34\x50\xed\x6f\x4d\xfe\x9c\x2b\x7a\x66\x51\xf9\x57\xea\x4e\xec\x16\xcc\x69\xb5\x85\xfb\xcd\x87\xf7\xd6\x82\xa2\x62\xcf\x16\x66\xc1\xb9\x7c\x81\x1e\xd8\xc1\x39\x6b\x7b\xd2\x0c\x52\x6b\xd1\xa2\x90\x06\x8a\x8d\xe0\xa7\x8d\x40\xc0\xc3\xe3\x08\xf9\xff\x5c\x55\x0e\x4c\x29\xa9\x32\xb0\x49\x1c\x3d\x51\x85\x9f\xa1\x94\xc4\x49\x1c\xad\xd7\xa0\x5b\x1e\x7c\x26\x71\x14\xe8\x8b\x4f\xc2\x30\x75\x94\x9c\x1a\xa4\x78\xa2\x0a\xf9\xb1\x63\xce\xed\xa4\xd0\x66\x7c\x0e\x42\x9e\x50\xc2\xe8\x6a\xd5\xe4\xb0\xe2\x53\x46\xc1\x40\x53\xc3\xaa\xc1\x0b\xef\xc6\xbb\xa1\x9a\x36\xa2\x62\xcf\xe7\x09\xaf\x9a\x0c\xc1\x21\x9b\x57\x10\xf3\xce\xcc\x5e\xf0\x2e\xb0\x7a\xed\xdc\x4f\x6b\x51\x4b\x38\x0c\xc1\x04\xd7\xaa\x13\xa3\x6b\x3f\x7c\x69\xb0\xf2\x5a\x3a\xb3\xc6\x2f\xbb\xb3\x88\x6d\x2e\xa8\xcf\x6c\x9a\xcb\x29\xb0\x3e\x0a\x54\x17\xd6\x66\x16\xf8\x40\x95\xc4\x11\x46\x55\x42\xb5\x0d\x52\xbe\xc9\xdf\x7f\xd1\x78\x59\x4a\x56\x7c\xdf\x51\x81\x93\x53\x37\x8c\x57\xb8\x98\xba\x7f\xea\x23\x16\x34\xa4\x47\xd5\x08\x03\xe4\x8a\xf4\x7a\xb2\x20\x3c\x6a\x6a\x3f\x2e\xff\x95\x20\x1a\xee\x27\x28\x0a\xab\x80\xdf\x7e\xb2\x92\x38\x0a\x2d\xed\xeb\x57\x73\x4f\x39\xc2\x66\xfb\xe6\x4d\xb5\xfe\x1e\xce\xc7\x60\xec\x6d\xae\xfe\x41\x5e\x54\xb1\x9a\x29\x68\x8b\x5b\x2e\x35\x4b\xb3\x61\x06\xb8\xa4\xd5\xb0\xd1\xde\x82\xff\xb5\x3c\x3c\xbe\x58\x22\x8b\x24\xb5\x44\x8a\x3b\xf6\x6c\xd2\xb0\x4d\xd1\x22\xbf\x9b\xf2\x45\x84\x36\xb4\xc6\x2f\xd9\x8e\x0a\x3c\x86\x48\xdb\xb7\x47\x72\xc9\xf4\x05\xd7\x7d\x2a\xde\x53\x09\xf4\x78\x64\xa2\x4a\x15\xd3\xf9\x32\xa1\xec\x3c\x3f\x0f\x99\x52\xeb\x57\xc6\x43\xfe\x04\x00\x00\xff\xff\x9b\xab\xcf\x10\xe6\x05\x00\x00"
-
-func mysqlQueryGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_mysqlQueryGoTpl,
-		"mysql.query.go.tpl",
-	)
-}
-
-func mysqlQueryGoTpl() (*asset, error) {
-	bytes, err := mysqlQueryGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mysql.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mysqlQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8f\xcf\x4a\xc4\x30\x10\xc6\xcf\x5b\xe8\x3b\x7c\x2c\xc2\xea\x61\xb3\xf7\x05\x4f\x82\x47\x2f\xf6\x01\x1a\xdb\xa9\x56\xf2\xa7\x24\x29\x22\xc3\xbc\xbb\xa4\x69\xd5\xee\x21\x13\x98\xf9\xcd\x8f\x6f\x98\xcf\xb8\x4b\xfa\xcd\x10\xae\x8f\xb8\x8f\xdd\x07\x59\x0d\xf5\xba\xfe\x4d\x9e\x94\xfa\xa2\x2d\x3d\xe0\x2c\x52\x57\x79\x69\x1c\xa0\x9e\xbc\xb5\xe4\x52\x69\x5e\x2e\x60\xfe\xeb\x6d\x1c\x99\x48\x3b\x20\x7b\x20\x82\x40\x53\xa0\x48\x2e\x45\x68\x04\xff\x85\x21\x78\x8b\x13\xf3\x96\x47\xe4\xa4\x56\x87\xeb\x17\x5f\xfa\x9e\x68\xe7\x88\x29\xcc\x5d\x02\x17\x2c\x68\xf7\x4e\x50\xcf\x23\x99\x3e\x2e\x0b\x87\xff\x30\x33\x02\x2d\x0a\xd5\xe4\x2a\x82\xf6\x33\x7a\x77\x3d\x96\xdc\x26\xbf\xd9\xba\x95\x3f\xb6\xf8\x3d\xe9\x66\x74\xd8\xc7\x92\xba\xaa\xab\x9f\x00\x00\x00\xff\xff\x8a\xe5\x0f\x67\x49\x01\x00\x00"
-
-func mysqlQuerytypeGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_mysqlQuerytypeGoTpl,
-		"mysql.querytype.go.tpl",
-	)
-}
-
-func mysqlQuerytypeGoTpl() (*asset, error) {
-	bytes, err := mysqlQuerytypeGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mysql.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mysqlTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x98\xff\x6f\xda\x46\x14\xc0\x7f\x36\x12\xff\xc3\xab\x35\xad\x76\x47\xcd\xf6\x6b\xa4\x68\x6a\x13\xba\x46\x4d\x93\x2e\xd0\xb5\xd2\x34\x95\x03\x3f\xe0\x16\xfb\x8e\xdc\x9d\x29\x08\xf9\x7f\x9f\xee\xce\x36\xb6\x71\x08\x4e\x93\x45\x1a\xac\xf6\xbb\xf7\xdd\x9f\xf7\xcc\x76\xfb\x1a\x7e\x92\x0b\x2e\x14\x9c\x9c\x82\x67\xfe\x8f\x91\x18\x21\xb8\xd2\x9f\x2e\x0a\xe1\x82\x2b\x50\xba\xe0\xca\xbb\x48\x2a\xfd\xcf\x70\xe2\x82\xfb\xf5\xfa\x92\xcf\x5d\x1f\x5e\xa7\x69\xb7\x63\xd4\x28\x32\x89\xd0\xaa\x99\x2e\x30\x26\x10\x0c\xb3\xef\x91\xbe\x63\x3f\xb5\xda\xd2\x21\x3a\x83\xe0\x8c\xc7\x31\x32\x65\x2f\xf6\xfb\xb0\xdd\xee\xae\xe5\x72\x18\x49\xac\x08\x18\xf7\xd2\x14\x04\x2e\x05\x4a\x64\x4a\x02\x01\xc1\xbf\xc3\x4c\xf0\x18\x5e\x6e\xb7\xb9\x3f\x69\xfa\x32\xc8\x74\xb0\xd0\xe8\x53\x9b\x25\x56\x74\x48\x25\x92\xa9\x82\xad\x15\x13\x84\xcd\x11\x82\x77\x14\xa3\x50\x9a\x03\x4e\x59\x78\xbb\x05\x81\x46\x45\x30\xd2\x9f\x69\x0a\xe3\x7f\x25\x67\x27\xae\xf5\x3b\xd2\xff\x25\x31\xcb\xe4\xdd\x31\x14\x21\xd5\x6e\x55\xbd\xca\xb3\xf1\x49\xd0\x98\x88\xcd\x07\xdc\x98\xcb\xdd\x8e\xd3\xef\xc3\x9a\xc3\xcc\xf8\xd3\xed\x38\xdf\x70\x4d\xa5\x92\x3d\xf8\x16\x62\x84\x0a\x43\x98\x70\x1e\x69\x05\x15\x5d\x79\x18\x5c\x20\x9d\xb3\x0f\xb8\xd9\xc5\x32\xb3\xd7\x4c\x84\xc6\x17\x1b\x6c\x1e\xe2\xbb\x0f\xf0\x4a\x3b\x7c\x83\x33\x1d\x61\x11\xf9\x2e\xcc\x4c\xc1\xf9\xdb\xf2\xe9\xfd\xd0\x21\x9c\xb4\x11\x1f\x57\x13\x62\x82\xcf\xb3\x32\xbc\x8b\xd6\xd6\xff\x7e\x1f\xfa\x4f\xf5\x67\xd5\xe5\x7f\xef\x31\x5a\xa2\x80\x59\xc2\xa6\x8a\x72\x26\xb5\xdf\x70\x97\xa0\xd8\x50\x36\x87\x44\xea\x4f\xb5\x40\x90\xda\x97\x88\x4e\x04\x11\x9b\x27\x77\xa8\xdb\x71\xb4\x03\xf0\xa7\xb6\x5b\xea\x3b\xef\xce\xd8\x0d\xcc\x75\x14\x3d\xeb\x98\x6e\x5d\xca\xe6\x3d\x20\x62\x2e\x21\x08\x02\xca\x14\x8a\x19\x99\xe2\x36\xf5\xc1\x7b\x55\x52\xd0\x03\x14\x82\x0b\x5f\xb7\xb9\xe3\xac\x88\x80\x10\xa5\x82\xed\x36\x28\xda\xd1\x71\x50\x08\xfd\x00\x1b\x4b\x7f\xa0\xf2\xee\x7a\xf0\xb3\x16\xcb\xcc\x59\x3b\x41\x10\xf8\x5a\x58\xa0\x4a\x04\xcb\x05\x50\x88\x6e\xc7\x49\xf7\x23\x98\x72\xb6\x42\xa1\xae\x76\x54\x49\x53\xf9\xa8\x70\xfe\xfe\xe7\x88\x80\x8c\xd0\x7d\x31\x0d\x31\xc2\xe9\x71\x61\x3d\x10\x55\x6e\xe1\x0b\x55\x8b\x33\xb5\xf6\xa6\x6a\xad\x23\x55\xb8\x56\xc1\x99\xfd\xee\x41\x35\xc8\xdd\xe5\xff\xa1\x74\x99\x31\xed\x57\x0f\x9e\xad\x8c\xcf\x15\xfd\x53\x55\xba\x75\x16\x6a\x49\x28\xf3\xc8\x10\xb6\x81\xd0\xfd\x3e\x0c\x0c\x91\x21\x44\x85\x22\xa6\x0c\xa5\x96\xd3\xa8\x28\x0f\x0e\x8b\x6d\xa0\xcc\xdc\x09\x89\x22\x13\x22\x31\xe8\x76\x4c\x9a\x3d\x3d\xb1\xec\x20\x4e\x53\x28\x47\xef\x67\xea\x3d\xdf\x80\xde\x24\x21\xf3\xb6\x7c\x26\xc8\xe6\x42\x06\xce\x7e\x1f\xce\xb3\xf1\xb0\x14\x7c\x45\x43\xed\x14\x9b\x71\x11\x13\x0d\xb7\x26\x07\x17\x44\xc2\x04\x51\x27\xc1\x1e\x34\xa3\xb4\xad\xb3\x99\xd5\x07\xbd\xcd\x8c\xec\xdc\xbd\x60\x12\x85\x02\x6a\xbe\xe4\x9e\x77\x8a\xb7\x76\xc5\x6a\xf4\xc2\x09\x7c\xbd\x3e\x7f\xeb\xdb\x2e\x32\x1e\xe9\x1e\xd2\xdd\x62\xae\xe4\x43\x96\xce\x80\x44\x02\x49\xb8\xb1\xb5\xea\xc1\x84\xd0\xa8\xdb\x71\xe8\xac\x31\xd3\xb6\x1d\xb3\xe0\x8c\x26\x19\x5c\xe1\x77\xcf\xb5\x21\xc0\x8c\xd0\x08\xc3\x93\xaa\x52\xe9\xfa\xf9\xf3\x55\x34\x94\x5d\x8f\x3e\x12\x96\x90\xe8\xd3\x2d\x14\x73\x4e\xde\x45\x59\x3a\xf2\x8e\x5d\xda\xd6\x83\x5b\xdc\x40\x9c\x48\x05\x13\xcc\xcb\x1b\x76\x3b\xce\x94\x33\xa9\xc0\xee\x69\x70\x0a\xe3\x8b\xab\xe1\xe0\x66\x04\x17\x57\xa3\x6b\x28\x6f\x44\xe0\x8d\xe1\x17\xed\xfc\xd8\x3c\xd8\x91\xde\xf8\x64\x69\xe5\xc9\xef\xfa\xf0\xd7\x9b\xcb\xcf\x83\x61\x5d\x7e\x45\xa2\x46\xf1\x71\x9e\x4a\x91\x30\xeb\x72\xb7\xe3\x98\x45\xd1\xb3\x4e\xf5\xb4\x1b\x66\x93\xa9\x9a\x2c\x72\xab\x93\xf3\xcd\x3c\x80\x70\x0a\xe1\x24\x18\xac\x71\xda\xe6\x2c\x9d\x99\xb3\x2f\x4e\x81\xd1\xa8\x5e\xa0\x02\x6c\x3a\xb7\xa8\x6c\x41\x90\x4d\xd1\xae\x45\xfb\x05\x3e\x05\x25\x12\xb4\xab\x95\x5e\x40\x8f\x2b\x4c\x5e\x10\x98\x6c\x80\x24\x8a\x53\x36\x15\xa8\xb7\xd9\x27\xab\x50\x09\x41\x79\xb7\xb7\x2a\xd9\xa1\xf3\x3f\x5a\xc3\x06\xdd\xbe\x61\x80\xb4\x65\x3d\x69\x59\xd7\x7b\xf4\x1d\x5d\x68\x81\x4a\x50\x5c\x21\x50\xfd\x84\xd0\xb0\xf0\x42\xa0\x0c\x2e\x89\x54\x96\x12\x17\xa1\xd7\xba\x7f\xca\x45\x27\x2c\xbc\xbf\x9f\x34\x95\xf6\xc3\x80\x53\xa8\xdd\xc8\x5e\x28\x3c\x1a\xfa\xc7\xb4\x64\x31\x98\x72\x1f\x99\xc6\x55\x69\x54\x31\x04\x6f\x97\xd9\x38\x89\x14\x3d\x90\x5e\x7b\xc3\x07\xd7\x2d\x1a\xfd\xf3\x32\x24\x0a\x21\x31\x5f\xfb\x4c\xde\x9f\x65\xce\x83\x50\xb6\x2a\x9b\xa0\xdc\x40\xe5\x0c\xcb\x21\x47\xc9\x5e\xaa\x1a\x96\x75\xb1\x5e\xdc\x0f\xe6\x26\x32\xdb\x40\x0a\x32\x6b\xbd\xc0\x78\xa6\xd8\x90\x39\xab\x70\x6e\xd8\x0e\xa9\x8a\xc9\xc6\x31\x76\xb4\xc9\x98\x88\x5b\x3d\x5a\xb9\xb0\xca\x29\x67\x15\xbb\xb6\x70\x73\x05\x1e\x44\xc8\xf6\xcb\x03\x3e\xfc\x96\xad\x3b\x39\x89\xec\x42\xf5\x9d\xaa\x05\x4c\x79\xbc\xe4\x92\x2a\x2c\xf7\xa6\x91\xad\x83\xe7\xf3\xa7\xf3\x37\xa3\x41\x95\x39\xc3\xc1\x08\x32\x0e\x54\xc1\x63\x2c\x54\xdb\xc7\xed\x81\x0b\xbf\x36\xf8\x57\xa0\xc4\x71\xc6\xf0\xe5\xfd\xe0\xc6\x18\xa9\x68\x6a\x38\xe5\xc2\x9b\xab\x73\xd0\x8d\x67\xf9\xe3\xd4\x09\xe4\x1c\x62\xd0\x71\x9d\x6d\x36\xc9\x1a\x6b\xf6\x64\xec\x61\xcb\x18\xe7\xc8\x51\xf4\x6c\xe6\x2b\xe8\x71\x8a\xdf\x41\x1a\xca\xff\x44\x35\xae\x96\xf7\xfe\x31\xd1\x54\xdb\x8a\xf8\x19\x8f\x2c\xe1\x7e\x7f\x4c\x3d\x8f\x98\x01\x3d\x38\x82\xb0\x6d\x8a\xf8\xb4\x36\x1b\x2a\x97\xd1\x7a\x37\x42\x86\x64\x85\x20\xc9\xaa\x01\xac\xfb\xcb\xee\xc3\x60\xd5\xea\x1a\xb1\x5a\x67\x56\xf1\x3a\x51\x61\x56\x45\xa4\x80\x74\x19\x4d\x4d\x82\xc5\x8a\xed\xe7\x2f\x4c\xd5\x4d\x29\x1b\x20\x52\x11\x65\x56\x20\x09\x3c\xa6\x4a\x03\x33\x4c\x50\x87\x19\x91\xe9\x2d\xf0\x59\xf6\xf3\x16\x70\xb5\x40\x01\x6a\x41\x58\x95\x60\x95\x71\x57\xbc\xdb\x64\x7c\xde\xcf\xdf\x0f\xbc\xb9\xb4\x79\x5d\x68\x9e\x4b\x87\xc7\x52\x79\x54\xef\x7a\xa1\x61\xd6\x1c\x1e\x35\x0d\x6a\x5a\x8c\x8e\x16\x93\xa3\x0e\x95\xf3\xc1\xe5\x60\x34\x80\x77\x37\xd7\x1f\xab\x64\x79\x3c\xeb\xeb\x68\x38\x48\x86\x7b\x90\x99\x3f\x79\xc7\x3e\xec\x0f\xa8\x69\xd8\x03\x6b\xcf\xb4\xfd\x2d\xb5\x42\xe4\x3a\x90\x1f\x9d\xba\xc3\x28\x7d\x30\x5d\x47\x21\xea\x50\xa2\x8e\x52\xd0\x22\x45\xbb\x45\x35\xdb\x9c\x8b\x97\x7f\xa7\xb9\xc5\xf3\x2d\x77\x7f\xb5\xad\xea\xfb\x2f\x00\x00\xff\xff\x5e\x94\xf0\x0d\xbf\x18\x00\x00"
-
-func mysqlTypeGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_mysqlTypeGoTpl,
-		"mysql.type.go.tpl",
-	)
-}
-
-func mysqlTypeGoTpl() (*asset, error) {
-	bytes, err := mysqlTypeGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mysql.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _oracleForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\x06\xf2\x1f\xde\x41\xd8\x64\xd9\xed\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x24\xa9\x5a\x42\xfe\xbb\x24\xed\x76\xeb\xd2\x43\x20\x7c\xf3\x66\xe6\xbd\xf1\x7e\x8b\x6b\x5b\x6b\xe3\x70\x73\x0b\x91\x7e\x2a\x6f\x09\xd9\x5b\xff\x45\xd9\x73\xde\x92\xc4\x36\x04\xce\x76\x3b\x78\x8f\x44\x10\x02\x0c\xb9\xce\x28\x0b\x57\x53\xe2\xaf\x54\x4d\x1d\xb1\x9e\x5b\xab\x8b\x26\x77\x54\xe2\xa7\x71\xf5\xa4\x9b\x8b\x56\x36\xa1\x87\x86\x8e\xe5\xd4\x28\xce\xe8\x4e\x1f\xe3\xeb\x5a\x35\x16\x65\x16\x7d\x24\x2f\x8f\xa4\xc8\xa4\xf1\x95\xd1\x2d\x2a\x6d\xa8\xf9\x50\xf8\xa4\x1e\xab\x34\x61\x00\x4f\xd4\xcf\xbe\xa7\xbd\x19\x67\x55\xa7\x8a\xb4\x6b\x4c\x1f\x02\xd6\x97\xfe\xe4\x3c\xb1\x28\x0f\x78\x7f\xb9\xdf\x4b\x88\xf5\x42\xe0\x0d\xc8\x18\x6d\x24\x3c\x67\x57\xc3\x71\x96\xee\xb2\xef\x47\xf8\x2f\xb4\x28\x0f\x9b\xa8\x2e\xb4\xfa\xa6\x5f\x77\xf2\x34\x9c\xe1\x2c\x8f\x96\x38\x0b\x9c\x71\xf6\x17\x00\x00\xff\xff\x59\x1b\xe5\x5b\xb8\x01\x00\x00"
-
-func oracleForeignkeyGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_oracleForeignkeyGoTpl,
-		"oracle.foreignkey.go.tpl",
-	)
-}
-
-func oracleForeignkeyGoTpl() (*asset, error) {
-	bytes, err := oracleForeignkeyGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "oracle.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _oracleIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x61\x4f\xdb\x30\x10\xfd\x9c\x48\xf9\x0f\x37\x6b\x82\x74\x2b\xe9\x77\xa4\x7e\xd8\xa0\x6c\xd3\x18\x6c\xc0\x34\x24\x84\x56\x37\xb9\x40\xa4\xd4\x6e\x6c\x07\xa8\x2c\xff\xf7\xe9\xec\x34\x94\x52\x6d\xb0\x2f\xc6\xb1\x7d\xef\xde\xbd\xf7\xa8\xb5\x7b\xf0\x56\xdf\x4a\x65\x60\x7f\x0c\xa9\xdf\x09\x3e\x47\xc8\x2e\x96\x0b\xcc\x4e\x68\xcb\x50\x29\x06\x4c\x37\xb5\x36\xb4\x29\x66\x0c\x58\xc3\x80\x29\xd4\x0c\xd8\xe5\xe9\xb1\xbc\x61\x90\x1d\x55\x58\x17\x7a\x00\x7b\xce\x25\xb1\xc7\x35\x7c\x56\x63\xc0\xcd\x6f\x71\xce\x21\x3b\xef\xfe\x7a\xf0\x0b\xba\x0e\x2b\xf5\xe9\x2a\x47\x23\xb0\x16\xb2\xa3\x56\xe4\xbe\xbb\x73\xa0\xd0\xa8\x0a\xef\x50\x03\x07\x25\xef\xa1\x54\x72\x0e\xbb\xd6\xae\x3a\x38\xb7\x0b\x9c\x2e\xa9\xf0\x91\xb7\x73\x19\xc1\x79\xc8\x4f\x28\x50\x71\x83\x45\x28\xae\x44\x81\x0f\x1e\x22\xfb\x42\xdb\xb0\x76\x55\xbb\x59\x12\x97\xad\xc8\x37\x79\xa4\xc5\x0c\x2e\x4f\x0f\x3f\x5a\x0b\x37\x72\xc1\x15\x9f\xd7\x95\x36\xab\xc1\xc1\xa8\x16\xc3\xe2\xdc\x00\x52\x6b\xa1\x2a\x41\x48\xd3\xb7\xd0\x3f\x45\xd5\xf8\xeb\xab\x6b\x6b\x01\x45\x01\xce\xbd\xdb\xe4\x3c\x04\x54\x4a\xaa\x01\xd8\x24\x8e\xee\xb8\xa2\xcf\x70\x94\xc4\x49\x1c\x8d\x46\xa0\x9b\x1a\x9a\x16\xd5\x32\x89\xa3\x5c\x0a\x6d\x20\x58\x03\x63\x98\x9e\x4f\x8e\x27\x07\x17\x30\x85\xf7\x49\x1c\x45\x53\x6b\x21\x97\x35\x19\xaa\xbb\x2e\x1d\x59\xe7\xfa\x37\x47\x67\xa7\xdf\x60\x5d\xcc\xfe\xe6\xd7\xe7\xc9\xd9\x04\xd6\x30\x7c\xd7\x7e\x60\x06\x1f\x4e\x0e\x81\x81\x73\xd3\x15\x35\xd5\x8a\x9e\x9a\xcf\x45\x1a\xa8\xfd\x4d\xb2\x92\xd7\xda\x6b\x16\x52\x53\x95\x5b\x04\x4b\xe2\x88\x18\x86\xa0\x3a\x47\x99\xda\xd4\xcd\xfa\x37\xa1\xde\x9f\x7f\x57\xd5\x9c\xab\xe5\x57\x5c\x06\x80\xe8\x37\x3e\x54\xda\xe8\x7d\xdf\x76\x18\x20\xc9\x04\x1f\xbb\xc8\xf9\x19\x48\xec\x31\x14\xb3\xec\x07\x4d\x71\x26\xef\x5f\x33\x40\x76\x9e\x73\x41\xc6\x97\x74\xbb\x45\xf5\x74\xa1\x2a\x61\x80\xed\xb0\x6e\x96\x41\x98\x3b\xaa\x4a\x6f\xf3\x9b\x31\x88\xaa\xf6\xce\x47\x0a\x4d\xab\x04\x7d\xfb\x44\xf4\x0c\xbb\xf3\x9d\x75\x3d\x86\xf4\x2c\xc8\x87\x81\x4b\x12\x47\x8d\xaf\x23\xa9\x56\xe3\xbc\xce\x8c\x17\x93\x8a\x0a\x2c\x51\x41\x93\x1d\xd4\x52\x63\x3a\x58\x85\xa1\x96\xbc\x00\x85\xba\xad\x8d\xf6\xc4\x35\x91\xb9\xba\x7e\x16\x79\x4b\x20\xa5\x24\x88\x13\x7c\x30\x69\xc8\xfe\x8b\x1c\xff\x87\xe5\xcf\x3d\x7f\x6a\x7a\xa7\xa9\xff\xaf\xca\xb9\xa0\x6d\x48\x40\xf3\xff\x5e\x6e\xd3\x6d\x8b\x70\xab\xd6\x24\xcb\x18\xf8\x62\x81\xa2\x48\x15\xea\xe1\x53\x6b\x07\x9b\xc6\xfb\x27\x8f\x76\xfb\x1f\x91\x24\xf6\x4f\xfe\x04\x00\x00\xff\xff\xf2\x9f\x01\xf4\xcd\x05\x00\x00"
-
-func oracleIndexGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_oracleIndexGoTpl,
-		"oracle.index.go.tpl",
-	)
-}
-
-func oracleIndexGoTpl() (*asset, error) {
-	bytes, err := oracleIndexGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "oracle.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _oracleQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x6a\xdc\x3c\x10\xbd\xb6\xc1\xef\x30\x9f\x58\x82\xfd\xd5\xf1\xde\x07\x7c\xd3\x94\x42\xa1\x64\xfb\x77\x11\x08\x81\x6a\xd7\xf2\xd6\xa0\x95\xd6\x92\x9c\x66\x11\x7a\xf7\x32\x92\x7f\x37\x1b\x4a\x73\x27\x0f\x47\x47\xe7\xcc\x99\xb1\xb5\xd7\xb0\xd2\xbf\xa4\x32\x70\x53\x42\xea\x4f\x82\x1e\x18\x14\x3f\x4e\x47\x56\xdc\xe1\x91\x30\xa5\x08\x10\xdd\x72\x6d\xf0\x50\x6d\x09\x90\x96\x00\x51\x4c\x13\x20\xf7\x9b\xcf\x72\x4f\xa0\xf8\xda\x31\x75\xfa\x42\x15\x3d\xe8\x0c\xae\x9d\x4b\x62\x4f\xde\x62\xf9\x56\x1e\x0e\x4c\x18\x8d\x8f\x04\xe0\x58\x19\x91\x4d\x0d\x45\x5f\x0d\xc5\xf5\x1a\xac\x9d\x6a\x03\x8e\x71\xcd\x16\x00\x2f\xd2\x39\x50\x9d\xd0\x40\x61\xd7\x69\x23\x0f\xe0\xdf\xcd\x41\x31\xd3\x29\xd1\x88\x3d\x28\xa6\x3b\x6e\x34\x50\xed\x6f\x4d\xfe\x9c\x2b\x7a\x66\x51\xf9\x57\xea\x4e\xec\x16\xcc\x69\xb5\x85\xfb\xcd\x87\xf7\xd6\x82\xa2\x62\xcf\x16\x66\xc1\xb9\x7c\x81\x1e\xd8\xc1\x39\x6b\x7b\xd2\x0c\x52\x6b\xd1\xa2\x90\x06\x8a\x8d\xe0\xa7\x8d\x40\xc0\xc3\xe3\x08\xf9\xff\x5c\x55\x0e\x4c\x29\xa9\x32\xb0\x49\x1c\x3d\x51\x85\x9f\xa1\x94\xc4\x49\x1c\xad\xd7\xa0\x5b\x1e\x7c\x26\x71\x14\xe8\x8b\x4f\xc2\x30\x75\x94\x9c\x1a\xa4\x78\xa2\x0a\xf9\xb1\x63\xce\xed\xa4\xd0\x66\x7c\x0e\x42\x9e\x50\xc2\xe8\x6a\xd5\xe4\xb0\xe2\x53\x46\xc1\x40\x53\xc3\xaa\xc1\x0b\xef\xc6\xbb\xa1\x9a\x36\xa2\x62\xcf\xe7\x09\xaf\x9a\x0c\xc1\x21\x9b\x57\x10\xf3\xce\xcc\x5e\xf0\x2e\xb0\x7a\xed\xdc\x4f\x6b\x51\x4b\x38\x0c\xc1\x04\xd7\xaa\x13\xa3\x6b\x3f\x7c\x69\xb0\xf2\x5a\x3a\xb3\xc6\x2f\xbb\xb3\x88\x6d\x2e\xa8\xcf\x6c\x9a\xcb\x29\xb0\x3e\x0a\x54\x17\xd6\x66\x16\xf8\x40\x95\xc4\x11\x46\x55\x42\xb5\x0d\x52\xbe\xc9\xdf\x7f\xd1\x78\x59\x4a\x56\x7c\xdf\x51\x81\x93\x53\x37\x8c\x57\xb8\x98\xba\x7f\xea\x23\x16\x34\xa4\x47\xd5\x08\x03\xe4\x8a\xf4\x7a\xb2\x20\x3c\x6a\x6a\x3f\x2e\xff\x95\x20\x1a\xee\x27\x28\x0a\xab\x80\xdf\x7e\xb2\x92\x38\x0a\x2d\xed\xeb\x57\x73\x4f\x39\xc2\x66\xfb\xe6\x4d\xb5\xfe\x1e\xce\xc7\x60\xec\x6d\xae\xfe\x41\x5e\x54\xb1\x9a\x29\x68\x8b\x5b\x2e\x35\x4b\xb3\x61\x06\xb8\xa4\xd5\xb0\xd1\xde\x82\xff\xb5\x3c\x3c\xbe\x58\x22\x8b\x24\xb5\x44\x8a\x3b\xf6\x6c\xd2\xb0\x4d\xd1\x22\xbf\x9b\xf2\x45\x84\x36\xb4\xc6\x2f\xd9\x8e\x0a\x3c\x86\x48\xdb\xb7\x47\x72\xc9\xf4\x05\xd7\x7d\x2a\xde\x53\x09\xf4\x78\x64\xa2\x4a\x15\xd3\xf9\x32\xa1\xec\x3c\x3f\x0f\x99\x52\xeb\x57\xc6\x43\xfe\x04\x00\x00\xff\xff\x9b\xab\xcf\x10\xe6\x05\x00\x00"
-
-func oracleQueryGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_oracleQueryGoTpl,
-		"oracle.query.go.tpl",
-	)
-}
-
-func oracleQueryGoTpl() (*asset, error) {
-	bytes, err := oracleQueryGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "oracle.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _oracleQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8f\xcf\x4a\xc4\x30\x10\xc6\xcf\x5b\xe8\x3b\x7c\x2c\xc2\xea\x61\xb3\xf7\x05\x4f\x82\x47\x2f\xf6\x01\x1a\xdb\xa9\x56\xf2\xa7\x24\x29\x22\xc3\xbc\xbb\xa4\x69\xd5\xee\x21\x13\x98\xf9\xcd\x8f\x6f\x98\xcf\xb8\x4b\xfa\xcd\x10\xae\x8f\xb8\x8f\xdd\x07\x59\x0d\xf5\xba\xfe\x4d\x9e\x94\xfa\xa2\x2d\x3d\xe0\x2c\x52\x57\x79\x69\x1c\xa0\x9e\xbc\xb5\xe4\x52\x69\x5e\x2e\x60\xfe\xeb\x6d\x1c\x99\x48\x3b\x20\x7b\x20\x82\x40\x53\xa0\x48\x2e\x45\x68\x04\xff\x85\x21\x78\x8b\x13\xf3\x96\x47\xe4\xa4\x56\x87\xeb\x17\x5f\xfa\x9e\x68\xe7\x88\x29\xcc\x5d\x02\x17\x2c\x68\xf7\x4e\x50\xcf\x23\x99\x3e\x2e\x0b\x87\xff\x30\x33\x02\x2d\x0a\xd5\xe4\x2a\x82\xf6\x33\x7a\x77\x3d\x96\xdc\x26\xbf\xd9\xba\x95\x3f\xb6\xf8\x3d\xe9\x66\x74\xd8\xc7\x92\xba\xaa\xab\x9f\x00\x00\x00\xff\xff\x8a\xe5\x0f\x67\x49\x01\x00\x00"
-
-func oracleQuerytypeGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_oracleQuerytypeGoTpl,
-		"oracle.querytype.go.tpl",
-	)
-}
-
-func oracleQuerytypeGoTpl() (*asset, error) {
-	bytes, err := oracleQuerytypeGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "oracle.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _oracleTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x61\x6f\xdb\x36\x13\xfe\x2c\x03\xfe\x0f\x57\xe1\xc5\x5b\x29\x73\x25\xec\x6b\x80\x7c\x68\x13\xb7\x0d\x9a\x39\x5d\xec\xac\x05\x86\xa1\xa6\xa5\x53\xcc\x45\x22\x1d\x92\x76\x6d\x08\xfa\xef\x03\x49\x49\x96\x6c\x35\xb1\x97\x74\x06\x6a\x37\xd4\xf1\xee\x79\x8e\x77\x0f\x4f\x79\xfe\x06\xfe\x27\xe7\x5c\x28\x38\x3d\x03\xcf\xfc\x8f\x91\x0c\x21\x18\xe9\x6f\x17\x85\x70\xc1\x15\x28\x5d\x70\xe5\x43\x2a\x95\xfe\x33\x9e\xb9\xe0\x7e\xbd\xbe\xe2\x77\xae\x0f\x6f\x8a\xa2\xdf\x33\x6e\x14\x99\xa5\x68\xdd\x44\x73\xcc\x08\x04\xe3\xf2\x77\xa2\x9f\xd8\x6f\xed\xb6\xb1\x89\x26\x10\x9c\xf3\x2c\x43\xa6\xec\x62\x18\x42\x9e\x6f\xd7\x2a\x3b\x4c\x25\xb6\x0c\x0c\xbc\xa2\x00\x81\x0b\x81\x12\x99\x92\x40\x40\xf0\xef\x90\x08\x9e\xc1\xeb\x3c\xaf\xf0\x14\xc5\xeb\xa0\xf4\xc1\x62\xe3\x4f\x6d\x16\xd8\xf2\x21\x95\x58\x46\x0a\x72\x6b\x26\x08\xbb\x43\x08\xde\x53\x4c\x63\x69\x36\x38\x4d\xe3\x3c\x07\x81\xc6\x45\x30\xd1\xdf\x45\x01\xd3\xbf\x25\x67\xa7\xae\xc5\x9d\xea\x7f\xcb\x8c\x95\xf6\xee\x14\x6a\x4a\x3b\x8f\xda\xa8\xaa\x6c\x7c\x16\x34\x23\x62\xf3\x09\x37\x66\xb9\xdf\x73\xc2\x10\xd6\x1c\x12\x83\xa7\xdf\x73\xbe\xe1\x9a\x4a\x25\x07\xf0\x2d\xc6\x14\x15\xc6\x30\xe3\x3c\xd5\x0e\x5a\xbe\x2a\x1a\x5c\x20\xbd\x63\x9f\x70\xb3\xe5\x92\xd8\x35\xc3\xd0\x60\xb1\x64\x2b\x8a\xef\x3f\xc1\x89\x06\x7c\x83\x89\x66\x58\x33\xdf\xd2\x2c\x1d\x5c\xbc\x6b\xee\xde\xa7\x0e\xf1\xec\x18\xf3\x69\x3b\x21\x86\x7c\x95\x95\xf1\x43\xba\xb6\xf8\xc3\x10\xc2\x97\xfa\x58\x77\xd5\xe7\x23\xa6\x0b\x14\x90\x2c\x59\xa4\x28\x67\x52\xe3\x86\x87\x25\x8a\x0d\x65\x77\xb0\x94\xfa\x5b\xcd\x11\xa4\xc6\x92\xd2\x99\x20\x62\xf3\xe2\x80\xfa\x3d\x47\x03\x80\xdf\x75\xdc\x46\xdd\x79\x0f\x26\x6e\x60\xd6\x51\x0c\x2c\x30\x5d\xba\x94\xdd\x0d\x80\x88\x3b\x09\x41\x10\x50\xa6\x50\x24\x24\xc2\xbc\xf0\xc1\x3b\x69\x38\x18\x00\x0a\xc1\x85\xaf\xcb\xdc\x71\x56\x44\x40\x8c\x52\x41\x9e\x07\x75\x39\x3a\x0e\x0a\xa1\x1b\xd8\x44\xfa\x80\xca\x7b\x18\xc0\xff\xb5\x59\x19\xce\xc6\x09\x82\xc0\xd7\xc6\x02\xd5\x52\xb0\xca\x00\x85\xe8\xf7\x9c\x62\x9f\x41\xc4\xd9\x0a\x85\x1a\x6d\x55\xa5\x28\xe4\xbf\xa2\xf3\xe7\x5f\x07\x10\x32\x46\x3f\xe2\x34\xc6\x14\xa3\xc3\x68\x3d\xc1\xaa\x8a\xf0\x85\xaa\xf9\xb9\x5a\x7b\x91\x5a\x6b\xa6\x0a\xd7\x2a\x38\xb7\xbf\x03\x68\x93\xdc\x2e\xff\x07\x47\x57\x06\xd3\xb8\x06\xf0\xd3\x8e\xf1\x67\xb1\x7f\xa9\x93\x3e\x3a\x0b\x3b\x49\x68\xea\x91\x51\xd8\x0e\x85\x0e\x43\x18\x1a\x45\x86\x18\x15\x8a\x8c\x32\x94\xda\x4e\x4b\x45\xf3\xe2\xb0\xb2\x0d\x94\x99\x27\x31\x51\x64\x46\x24\x06\xfd\x9e\x49\xb3\xa7\x6f\x2c\x7b\x11\x17\x05\x34\xd9\xfb\xa5\x7b\xcf\x37\x42\x6f\x92\x50\xa2\x6d\xee\x09\xca\x7b\xa1\x14\xce\x30\x84\x8b\xf2\x7a\x58\x08\xbe\xa2\xb1\x06\xc5\x12\x2e\x32\xa2\xc5\xad\x0b\xe0\x9c\x48\x98\x21\xea\x24\xd8\x8d\xe6\x2a\x3d\x16\x6c\x19\xf5\x49\xb4\x65\x90\x2d\xdc\x4b\x26\x51\x28\xa0\xe6\x47\xee\xa1\x53\xfc\x68\x28\xd6\xa3\x17\xcf\xe0\xeb\xf5\xc5\x3b\xdf\x56\x91\x41\xa4\x6b\x48\x57\x8b\x59\xa9\x2e\x59\x9a\x00\x49\x05\x92\x78\x63\xcf\x6a\x00\x33\x42\xd3\x7e\xcf\xa1\x49\x67\xa6\x6d\x39\x96\xe4\x8c\x27\x19\x8c\xf0\xbb\xe7\x5a\x0a\x90\x10\x9a\x62\x7c\xda\x76\x2a\x5d\xbf\xee\xaf\x30\xd4\xb5\x6a\xab\xb1\xdf\x73\x22\xce\xa4\x02\x3b\x65\xc1\x19\x4c\x2f\x47\xe3\xe1\xcd\x04\x2e\x47\x93\x6b\x68\xce\x33\xe0\x4d\xe1\x17\x1d\x7a\x6a\xda\x32\xd5\xf3\x9a\xac\x07\x96\x46\x79\x56\x99\xa8\xcc\x7d\xf8\xe3\xed\xd5\xed\x70\xbc\xeb\x60\x45\xd2\x03\xf7\xdf\x0c\x27\xb7\x37\xa3\xcb\xd1\x07\xd8\x86\x6e\xed\x38\xe7\xa9\x46\x18\x9e\xa4\x44\x2a\x9b\xff\xcb\xf8\x24\xb4\x24\x4e\x17\xf7\xd3\x8a\xb8\x58\xb2\x9a\xb8\x19\x25\x3d\x4b\x7c\xa0\x3d\x9b\x59\xa7\x4d\xab\xcc\x7e\x07\xba\x01\x30\x9a\xfa\xa6\xcc\xa4\x69\x5d\xad\x01\xf1\x2c\x18\xae\x31\x7a\xbe\x53\x9a\x18\x97\xaf\xce\xf4\xc2\xee\x89\x37\x4f\x52\xa0\x12\x14\x57\x08\x34\xd6\xdb\xe2\x1a\x8a\x40\x19\x5c\x35\xb2\xe1\x1d\xe5\x56\xa2\x82\x85\x85\x07\xf7\xb8\x01\xc2\x62\x5b\x48\xc8\x22\xb4\xe3\xdc\xb6\x30\x75\xf5\xef\x73\x81\x33\xd8\x79\x50\x0e\xae\x1e\x8d\xfd\x5d\x17\x55\x6d\x9f\x81\x12\x4b\x34\x28\x4a\x60\x4c\xf7\x42\x43\x07\x19\x82\x77\x78\x4e\x7d\x70\xdd\x7a\x80\xbb\x5d\xc4\x44\x21\x2c\xcd\xcf\x7e\xab\xef\x4b\xa4\xf3\x64\xaf\x5b\x97\x5d\xbd\xde\xd1\xec\x65\xb7\xc7\x1c\x25\x7b\xad\x76\xba\x5d\x9f\xcd\xab\x1f\xf7\x7b\x57\xc3\x5b\x22\x75\xc3\x6b\xbf\xc0\x78\xe9\xd8\x34\x7c\x79\xa0\x55\x60\xab\x7d\xad\x90\x9d\xea\x78\x70\xc8\x8c\x88\x7b\xad\xd8\x5c\x58\xe7\x94\xb3\xdd\xb8\x4d\xa9\xd9\xd3\x9a\xdb\xcf\x17\x6f\x27\xc3\xb6\xcc\x8c\x87\x13\x28\x5b\xbf\x25\x35\xf6\x02\xaf\xce\xdb\x1d\x80\xfb\x88\x68\x38\x53\xf8\xf2\x71\x78\x33\x7c\x42\x30\xce\xe0\xd4\x1a\x44\x7c\xc9\xd4\xa3\x62\x54\x13\x6a\x4a\xc8\xb3\x35\xe4\x80\x3e\x32\xf9\xfc\x66\xfb\xfa\x25\x14\xe6\xd0\x90\xdd\xc2\x30\x26\x2b\x04\x49\x56\x1d\xfd\xb3\x7f\x55\x3e\xdd\x3f\xda\x5d\x67\xf7\xec\x96\x66\x3d\x8c\xb4\x4a\xb3\x65\x52\xf7\x62\xb3\x02\xbb\x0c\xeb\x0b\xda\xaf\xc6\x2d\xfb\xa2\xbf\xa3\x13\x52\x11\x85\x99\x79\xc7\xe7\x19\x55\xba\x2f\xe2\x25\x6a\x9a\x29\x89\xee\x81\x27\xe5\xcb\x31\x70\x35\x47\x01\x6a\x4e\x58\x53\x33\x5b\xaf\xc6\x8d\xc9\xa8\x6c\xc3\xfd\xfc\x3d\x63\xee\x39\x66\xd8\xe8\x96\x9f\xc7\xd5\xa7\xa9\xc5\xdb\x5a\xe8\x90\x94\xc7\x15\xa5\xdb\xcd\x63\xc3\xc8\xc5\xf0\x6a\x38\x19\xc2\xfb\x9b\xeb\xdf\xda\x2a\x71\x60\x77\xff\x7a\xd8\xcd\x7f\x48\x43\x3c\xd6\x82\x87\xec\x3f\xea\xee\xad\xc7\x54\xa7\x3b\x9d\x3f\xbe\x27\xdb\xef\x0e\xff\x04\x00\x00\xff\xff\x25\x46\x74\x38\x69\x13\x00\x00"
-
-func oracleTypeGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_oracleTypeGoTpl,
-		"oracle.type.go.tpl",
-	)
-}
-
-func oracleTypeGoTpl() (*asset, error) {
-	bytes, err := oracleTypeGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "oracle.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _postgresEnumGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\xc1\x6a\xdc\x30\x10\x3d\xdb\xe0\x7f\x98\x8a\x42\xec\x90\x78\xe9\xa5\x87\xc0\x9e\x4a\x8f\xcd\xa1\x69\x73\x29\x3d\x68\xbd\xa3\xac\x88\x2d\x37\x92\xbc\x49\x30\xfa\xf7\xa2\x91\xdc\x4a\xdb\x0d\x6d\x0a\x7b\x59\xcc\x68\xf4\xf4\xe6\xbd\x37\xec\x3c\x5f\xc2\x5b\xfb\xfc\x03\xe1\x6a\x0d\xed\x35\x1f\x10\x2e\x9d\xab\x4a\xaa\x9b\xdd\xa8\xad\x3f\xa8\xe9\x4b\xf9\xd3\xd0\xcc\x50\x4d\xc3\x2d\xef\x19\x30\x8b\x4f\x96\x01\xdb\x4c\x82\x01\x1b\xef\x19\x30\xa3\x3b\xd6\x24\x30\x1a\xf7\xa8\x0d\x7a\x70\x43\xcf\x7c\x0e\x85\x0f\xa3\x32\x36\x54\xa9\x79\xb5\x82\x79\x8e\x0f\x38\x07\xd2\x80\xdd\x21\x9c\xcd\x33\xb4\x1f\xd5\x34\xd0\x0f\x31\x74\xee\x0c\x3c\x01\xa0\x56\xa1\xc7\x01\x4c\xb7\xc3\x81\x87\xe6\x9b\xf0\xed\xdb\xda\xaa\xa4\x9e\x14\x77\x92\xca\xbe\x7b\x5f\x95\x55\xd9\x79\x02\x50\x07\x9a\x9a\xab\x3b\x84\xf6\x96\xf7\x13\x1a\xf0\x7c\x8a\x40\x48\x8a\x83\x11\x9c\xf3\xaf\x44\x26\x09\xf2\x3c\x03\xf6\xe6\xcf\x62\xd2\x8a\x6a\x7b\x38\xda\x2d\xef\x69\x32\x7a\x98\x46\x4b\x6e\xb7\x55\x59\x9c\x86\xc2\x3a\x7d\xa6\x5e\x88\x90\x25\x0b\x93\xc6\x0b\x43\xfd\x64\x4f\xe3\x25\x5b\xad\xe0\xc6\x6a\xa9\xee\x40\xa3\x9d\xb4\x0a\x83\x98\x50\xda\xd3\xc5\x51\x50\x2d\x9f\x42\x4c\xaa\x03\xff\x4c\x0c\x95\x73\x69\x43\x13\x41\xeb\x66\x81\x9a\xab\xb2\xd8\x73\x0d\x31\x67\xb1\xec\x19\x14\xe6\x51\xda\x6e\x07\x39\xd6\x8b\x1e\x76\xdc\xe0\x69\x5c\xbc\xaa\xca\xa2\x58\xf8\xad\x81\x1d\x33\x93\xe5\x12\x16\x8e\x26\x08\xd2\x2d\xb3\x55\xa5\x8b\xca\x7e\xe2\xda\xec\x78\xff\x05\x9f\x2c\x0c\xe1\xdb\xe4\x2b\xa1\xec\x08\x7e\xe1\xfe\x41\xd1\x04\xac\x6e\xa0\xfe\xf6\x7d\xf3\x6c\xf1\x02\x50\xeb\x51\x37\xa4\x6f\xa4\x11\x4e\x32\xa4\x76\xb1\xa3\xb9\x00\x25\x13\x86\x5f\xd5\x90\x70\x9c\xd4\x51\x96\xb4\x90\x2f\xb3\x3c\xcf\x68\x66\x88\xb5\xbf\x15\x09\x35\x81\x2a\x31\x8d\x96\x87\x10\x50\x53\x53\xfc\xc5\xf2\xe3\x76\x90\x67\xe7\x19\x9d\xf5\x69\xd2\xf1\xcb\x78\x17\x3c\xdf\xa2\xe0\x53\x6f\x89\xc0\xe2\xbf\x9f\xcf\xb4\xd7\xf8\x58\x33\xa9\xf6\xbc\x97\xdb\x54\x47\xd6\x1c\x06\x26\xb3\x22\xcc\x64\xb8\x95\x46\x48\x8c\x7b\xf8\xd0\xaf\xb6\x5a\xee\x51\x07\x41\xb4\x4f\x0c\x6a\xc1\x3b\x04\xe1\xb5\x7c\xd5\x4e\x12\x84\xcf\x4e\x0a\x79\x2c\x41\x47\xa3\x73\x90\x9c\x9b\x8e\xab\x03\xb6\x5b\x6e\xf9\x86\x1b\x5c\x99\x87\xbe\xf5\xe7\xea\xf5\x84\xf3\x30\x79\x90\xda\xe8\xee\x37\xca\xec\xd2\x1c\x6d\x26\x71\x01\xe3\xbd\xff\x13\x32\xba\x6b\xe3\x52\x78\x9d\xa5\x80\x37\xe3\x3d\x35\x01\xc0\xff\x1a\x94\x09\x91\x27\x7b\x33\x89\x26\xaa\xf1\x33\x00\x00\xff\xff\xa9\x41\xf5\xd0\x74\x07\x00\x00"
-
-func postgresEnumGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_postgresEnumGoTpl,
-		"postgres.enum.go.tpl",
-	)
-}
-
-func postgresEnumGoTpl() (*asset, error) {
-	bytes, err := postgresEnumGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "postgres.enum.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _postgresForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\x06\xf2\x1f\xde\x41\xd8\x64\xd9\xed\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x24\xa9\x5a\x42\xfe\xbb\x24\xed\x76\xeb\xd2\x43\x20\x7c\xf3\x66\xe6\xbd\xf1\x7e\x8b\x6b\x5b\x6b\xe3\x70\x73\x0b\x91\x7e\x2a\x6f\x09\xd9\x5b\xff\x45\xd9\x73\xde\x92\xc4\x36\x04\xce\x76\x3b\x78\x8f\x44\x10\x02\x0c\xb9\xce\x28\x0b\x57\x53\xe2\xaf\x54\x4d\x1d\xb1\x9e\x5b\xab\x8b\x26\x77\x54\xe2\xa7\x71\xf5\xa4\x9b\x8b\x56\x36\xa1\x87\x86\x8e\xe5\xd4\x28\xce\xe8\x4e\x1f\xe3\xeb\x5a\x35\x16\x65\x16\x7d\x24\x2f\x8f\xa4\xc8\xa4\xf1\x95\xd1\x2d\x2a\x6d\xa8\xf9\x50\xf8\xa4\x1e\xab\x34\x61\x00\x4f\xd4\xcf\xbe\xa7\xbd\x19\x67\x55\xa7\x8a\xb4\x6b\x4c\x1f\x02\xd6\x97\xfe\xe4\x3c\xb1\x28\x0f\x78\x7f\xb9\xdf\x4b\x88\xf5\x42\xe0\x0d\xc8\x18\x6d\x24\x3c\x67\x57\xc3\x71\x96\xee\xb2\xef\x47\xf8\x2f\xb4\x28\x0f\x9b\xa8\x2e\xb4\xfa\xa6\x5f\x77\xf2\x34\x9c\xe1\x2c\x8f\x96\x38\x0b\x9c\x71\xf6\x17\x00\x00\xff\xff\x59\x1b\xe5\x5b\xb8\x01\x00\x00"
-
-func postgresForeignkeyGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_postgresForeignkeyGoTpl,
-		"postgres.foreignkey.go.tpl",
-	)
-}
-
-func postgresForeignkeyGoTpl() (*asset, error) {
-	bytes, err := postgresForeignkeyGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "postgres.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _postgresIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x61\x4f\xdb\x30\x10\xfd\x9c\x48\xf9\x0f\x37\x6b\x82\x74\x2b\xe9\x77\xa4\x7e\xd8\xa0\x6c\xd3\x18\x6c\xc0\x34\x24\x84\x56\x37\xb9\x40\xa4\xd4\x6e\x6c\x07\xa8\x2c\xff\xf7\xe9\xec\x34\x94\x52\x6d\xb0\x2f\xc6\xb1\x7d\xef\xde\xbd\xf7\xa8\xb5\x7b\xf0\x56\xdf\x4a\x65\x60\x7f\x0c\xa9\xdf\x09\x3e\x47\xc8\x2e\x96\x0b\xcc\x4e\x68\xcb\x50\x29\x06\x4c\x37\xb5\x36\xb4\x29\x66\x0c\x58\xc3\x80\x29\xd4\x0c\xd8\xe5\xe9\xb1\xbc\x61\x90\x1d\x55\x58\x17\x7a\x00\x7b\xce\x25\xb1\xc7\x35\x7c\x56\x63\xc0\xcd\x6f\x71\xce\x21\x3b\xef\xfe\x7a\xf0\x0b\xba\x0e\x2b\xf5\xe9\x2a\x47\x23\xb0\x16\xb2\xa3\x56\xe4\xbe\xbb\x73\xa0\xd0\xa8\x0a\xef\x50\x03\x07\x25\xef\xa1\x54\x72\x0e\xbb\xd6\xae\x3a\x38\xb7\x0b\x9c\x2e\xa9\xf0\x91\xb7\x73\x19\xc1\x79\xc8\x4f\x28\x50\x71\x83\x45\x28\xae\x44\x81\x0f\x1e\x22\xfb\x42\xdb\xb0\x76\x55\xbb\x59\x12\x97\xad\xc8\x37\x79\xa4\xc5\x0c\x2e\x4f\x0f\x3f\x5a\x0b\x37\x72\xc1\x15\x9f\xd7\x95\x36\xab\xc1\xc1\xa8\x16\xc3\xe2\xdc\x00\x52\x6b\xa1\x2a\x41\x48\xd3\xb7\xd0\x3f\x45\xd5\xf8\xeb\xab\x6b\x6b\x01\x45\x01\xce\xbd\xdb\xe4\x3c\x04\x54\x4a\xaa\x01\xd8\x24\x8e\xee\xb8\xa2\xcf\x70\x94\xc4\x49\x1c\x8d\x46\xa0\x9b\x1a\x9a\x16\xd5\x32\x89\xa3\x5c\x0a\x6d\x20\x58\x03\x63\x98\x9e\x4f\x8e\x27\x07\x17\x30\x85\xf7\x49\x1c\x45\x53\x6b\x21\x97\x35\x19\xaa\xbb\x2e\x1d\x59\xe7\xfa\x37\x47\x67\xa7\xdf\x60\x5d\xcc\xfe\xe6\xd7\xe7\xc9\xd9\x04\xd6\x30\x7c\xd7\x7e\x60\x06\x1f\x4e\x0e\x81\x81\x73\xd3\x15\x35\xd5\x8a\x9e\x9a\xcf\x45\x1a\xa8\xfd\x4d\xb2\x92\xd7\xda\x6b\x16\x52\x53\x95\x5b\x04\x4b\xe2\x88\x18\x86\xa0\x3a\x47\x99\xda\xd4\xcd\xfa\x37\xa1\xde\x9f\x7f\x57\xd5\x9c\xab\xe5\x57\x5c\x06\x80\xe8\x37\x3e\x54\xda\xe8\x7d\xdf\x76\x18\x20\xc9\x04\x1f\xbb\xc8\xf9\x19\x48\xec\x31\x14\xb3\xec\x07\x4d\x71\x26\xef\x5f\x33\x40\x76\x9e\x73\x41\xc6\x97\x74\xbb\x45\xf5\x74\xa1\x2a\x61\x80\xed\xb0\x6e\x96\x41\x98\x3b\xaa\x4a\x6f\xf3\x9b\x31\x88\xaa\xf6\xce\x47\x0a\x4d\xab\x04\x7d\xfb\x44\xf4\x0c\xbb\xf3\x9d\x75\x3d\x86\xf4\x2c\xc8\x87\x81\x4b\x12\x47\x8d\xaf\x23\xa9\x56\xe3\xbc\xce\x8c\x17\x93\x8a\x0a\x2c\x51\x41\x93\x1d\xd4\x52\x63\x3a\x58\x85\xa1\x96\xbc\x00\x85\xba\xad\x8d\xf6\xc4\x35\x91\xb9\xba\x7e\x16\x79\x4b\x20\xa5\x24\x88\x13\x7c\x30\x69\xc8\xfe\x8b\x1c\xff\x87\xe5\xcf\x3d\x7f\x6a\x7a\xa7\xa9\xff\xaf\xca\xb9\xa0\x6d\x48\x40\xf3\xff\x5e\x6e\xd3\x6d\x8b\x70\xab\xd6\x24\xcb\x18\xf8\x62\x81\xa2\x48\x15\xea\xe1\x53\x6b\x07\x9b\xc6\xfb\x27\x8f\x76\xfb\x1f\x91\x24\xf6\x4f\xfe\x04\x00\x00\xff\xff\xf2\x9f\x01\xf4\xcd\x05\x00\x00"
-
-func postgresIndexGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_postgresIndexGoTpl,
-		"postgres.index.go.tpl",
-	)
-}
-
-func postgresIndexGoTpl() (*asset, error) {
-	bytes, err := postgresIndexGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "postgres.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _postgresProcGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\x41\x6b\xdc\x30\x10\x85\xcf\x16\xe8\x3f\x4c\x43\x69\xbc\x90\x78\xef\x85\xbd\xb4\xdd\x5b\x48\xd2\x6c\x28\xb9\x35\x8a\x3c\xde\x08\xb4\xd2\x66\x24\x6f\x1b\x84\xfe\x7b\x19\x59\x9b\x3a\xa1\x2d\xf4\x60\x19\xcb\xf3\xbe\xf7\xe6\xa5\x74\x0e\xef\x9d\x8f\xdf\xbc\xe9\xe1\xe3\x0a\x5a\x87\xd0\x5d\x93\xd7\xdd\x0d\xc6\x91\xdc\xed\xf3\x1e\xe1\xe4\xe0\x4d\x7f\xb2\x80\xf3\x9c\xa5\x28\x8a\x3d\x79\x5d\xc6\x83\x7e\xc4\x9d\x82\x6e\x53\xdf\x45\xca\xc7\xa5\xda\xe1\x4c\x61\x06\xf8\x23\x39\x92\xd9\x6e\x91\x4e\xa6\xc9\xe5\x12\x52\x82\x8e\xb5\x90\x33\x68\x65\x6d\x80\xf8\x88\x10\xa2\x27\xec\x81\x6d\xb1\x1f\x09\xe1\x34\xa5\x9a\x22\xe7\x96\x35\x4c\xbe\x56\xa4\x76\x01\x72\x5e\xc0\xf1\x6a\x6e\x96\xf3\x29\x78\x07\xfd\x43\x27\xc5\x30\x3a\x3d\xf7\x6a\xfb\x07\xb8\xbb\xfa\xf2\x29\x25\xd8\xfa\x3d\x73\xac\x09\x11\xba\x8a\x8c\x34\xe2\x74\x30\x9c\x0d\xcd\xf0\xbb\xb7\x9c\x53\x02\xc2\xc8\x26\xd5\xb0\xab\x8e\x67\x6c\x82\x8e\x67\x90\xc8\xd3\x02\x92\x14\xcd\x41\x11\x20\x95\xc7\x93\x14\x52\x34\xcb\x25\x84\x27\x0b\x4f\x23\xd2\xb3\x14\x8d\xf6\x2e\x44\xbe\x09\x91\x60\x05\xf7\x9b\xf5\xc5\xfa\xf3\x2d\xbc\x59\x5a\x7b\x7b\x50\x36\xbc\xa4\xcc\x79\x71\x7f\xa4\xd1\xe8\x8e\xb4\xda\xff\x2c\x6e\x8d\x40\x18\xe1\xaf\xc9\xa5\x68\xee\xae\x2e\xfc\xb6\x9d\x52\xfc\xab\x98\x41\xd9\x50\x9a\x91\xa2\xe1\xb5\x56\xdc\xf1\x57\x36\xbf\xf1\x3f\xfe\x47\xdf\x6d\xb4\x72\xed\x07\xc2\xc8\x28\x33\x94\x92\xde\xad\xc0\x19\x5b\x7a\x6b\xa8\x44\x9c\x42\x3b\x63\x5f\xe5\xbe\x34\xf6\xa5\x74\x24\x92\xa2\xc9\xa5\x8c\xaa\x21\x8c\x67\x0c\x9a\xfa\xc0\xc9\xf1\xcd\x92\xec\xfa\xbd\xa8\xa7\x1d\xd6\x3f\x51\xcf\x7e\x55\x52\x81\x17\x88\x9b\xca\xcc\xaf\x3f\xa5\xf8\x15\x00\x00\xff\xff\x7f\x4c\x90\x55\x56\x03\x00\x00"
-
-func postgresProcGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_postgresProcGoTpl,
-		"postgres.proc.go.tpl",
-	)
-}
-
-func postgresProcGoTpl() (*asset, error) {
-	bytes, err := postgresProcGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "postgres.proc.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _postgresQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x6a\xdc\x3c\x10\xbd\xb6\xc1\xef\x30\x9f\x58\x82\xfd\xd5\xf1\xde\x07\x7c\xd3\x94\x42\xa1\x64\xfb\x77\x11\x08\x81\x6a\xd7\xf2\xd6\xa0\x95\xd6\x92\x9c\x66\x11\x7a\xf7\x32\x92\x7f\x37\x1b\x4a\x73\x27\x0f\x47\x47\xe7\xcc\x99\xb1\xb5\xd7\xb0\xd2\xbf\xa4\x32\x70\x53\x42\xea\x4f\x82\x1e\x18\x14\x3f\x4e\x47\x56\xdc\xe1\x91\x30\xa5\x08\x10\xdd\x72\x6d\xf0\x50\x6d\x09\x90\x96\x00\x51\x4c\x13\x20\xf7\x9b\xcf\x72\x4f\xa0\xf8\xda\x31\x75\xfa\x42\x15\x3d\xe8\x0c\xae\x9d\x4b\x62\x4f\xde\x62\xf9\x56\x1e\x0e\x4c\x18\x8d\x8f\x04\xe0\x58\x19\x91\x4d\x0d\x45\x5f\x0d\xc5\xf5\x1a\xac\x9d\x6a\x03\x8e\x71\xcd\x16\x00\x2f\xd2\x39\x50\x9d\xd0\x40\x61\xd7\x69\x23\x0f\xe0\xdf\xcd\x41\x31\xd3\x29\xd1\x88\x3d\x28\xa6\x3b\x6e\x34\x50\xed\x6f\x4d\xfe\x9c\x2b\x7a\x66\x51\xf9\x57\xea\x4e\xec\x16\xcc\x69\xb5\x85\xfb\xcd\x87\xf7\xd6\x82\xa2\x62\xcf\x16\x66\xc1\xb9\x7c\x81\x1e\xd8\xc1\x39\x6b\x7b\xd2\x0c\x52\x6b\xd1\xa2\x90\x06\x8a\x8d\xe0\xa7\x8d\x40\xc0\xc3\xe3\x08\xf9\xff\x5c\x55\x0e\x4c\x29\xa9\x32\xb0\x49\x1c\x3d\x51\x85\x9f\xa1\x94\xc4\x49\x1c\xad\xd7\xa0\x5b\x1e\x7c\x26\x71\x14\xe8\x8b\x4f\xc2\x30\x75\x94\x9c\x1a\xa4\x78\xa2\x0a\xf9\xb1\x63\xce\xed\xa4\xd0\x66\x7c\x0e\x42\x9e\x50\xc2\xe8\x6a\xd5\xe4\xb0\xe2\x53\x46\xc1\x40\x53\xc3\xaa\xc1\x0b\xef\xc6\xbb\xa1\x9a\x36\xa2\x62\xcf\xe7\x09\xaf\x9a\x0c\xc1\x21\x9b\x57\x10\xf3\xce\xcc\x5e\xf0\x2e\xb0\x7a\xed\xdc\x4f\x6b\x51\x4b\x38\x0c\xc1\x04\xd7\xaa\x13\xa3\x6b\x3f\x7c\x69\xb0\xf2\x5a\x3a\xb3\xc6\x2f\xbb\xb3\x88\x6d\x2e\xa8\xcf\x6c\x9a\xcb\x29\xb0\x3e\x0a\x54\x17\xd6\x66\x16\xf8\x40\x95\xc4\x11\x46\x55\x42\xb5\x0d\x52\xbe\xc9\xdf\x7f\xd1\x78\x59\x4a\x56\x7c\xdf\x51\x81\x93\x53\x37\x8c\x57\xb8\x98\xba\x7f\xea\x23\x16\x34\xa4\x47\xd5\x08\x03\xe4\x8a\xf4\x7a\xb2\x20\x3c\x6a\x6a\x3f\x2e\xff\x95\x20\x1a\xee\x27\x28\x0a\xab\x80\xdf\x7e\xb2\x92\x38\x0a\x2d\xed\xeb\x57\x73\x4f\x39\xc2\x66\xfb\xe6\x4d\xb5\xfe\x1e\xce\xc7\x60\xec\x6d\xae\xfe\x41\x5e\x54\xb1\x9a\x29\x68\x8b\x5b\x2e\x35\x4b\xb3\x61\x06\xb8\xa4\xd5\xb0\xd1\xde\x82\xff\xb5\x3c\x3c\xbe\x58\x22\x8b\x24\xb5\x44\x8a\x3b\xf6\x6c\xd2\xb0\x4d\xd1\x22\xbf\x9b\xf2\x45\x84\x36\xb4\xc6\x2f\xd9\x8e\x0a\x3c\x86\x48\xdb\xb7\x47\x72\xc9\xf4\x05\xd7\x7d\x2a\xde\x53\x09\xf4\x78\x64\xa2\x4a\x15\xd3\xf9\x32\xa1\xec\x3c\x3f\x0f\x99\x52\xeb\x57\xc6\x43\xfe\x04\x00\x00\xff\xff\x9b\xab\xcf\x10\xe6\x05\x00\x00"
-
-func postgresQueryGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_postgresQueryGoTpl,
-		"postgres.query.go.tpl",
-	)
-}
-
-func postgresQueryGoTpl() (*asset, error) {
-	bytes, err := postgresQueryGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "postgres.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _postgresQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8f\xcf\x4a\xc4\x30\x10\xc6\xcf\x5b\xe8\x3b\x7c\x2c\xc2\xea\x61\xb3\xf7\x05\x4f\x82\x47\x2f\xf6\x01\x1a\xdb\xa9\x56\xf2\xa7\x24\x29\x22\xc3\xbc\xbb\xa4\x69\xd5\xee\x21\x13\x98\xf9\xcd\x8f\x6f\x98\xcf\xb8\x4b\xfa\xcd\x10\xae\x8f\xb8\x8f\xdd\x07\x59\x0d\xf5\xba\xfe\x4d\x9e\x94\xfa\xa2\x2d\x3d\xe0\x2c\x52\x57\x79\x69\x1c\xa0\x9e\xbc\xb5\xe4\x52\x69\x5e\x2e\x60\xfe\xeb\x6d\x1c\x99\x48\x3b\x20\x7b\x20\x82\x40\x53\xa0\x48\x2e\x45\x68\x04\xff\x85\x21\x78\x8b\x13\xf3\x96\x47\xe4\xa4\x56\x87\xeb\x17\x5f\xfa\x9e\x68\xe7\x88\x29\xcc\x5d\x02\x17\x2c\x68\xf7\x4e\x50\xcf\x23\x99\x3e\x2e\x0b\x87\xff\x30\x33\x02\x2d\x0a\xd5\xe4\x2a\x82\xf6\x33\x7a\x77\x3d\x96\xdc\x26\xbf\xd9\xba\x95\x3f\xb6\xf8\x3d\xe9\x66\x74\xd8\xc7\x92\xba\xaa\xab\x9f\x00\x00\x00\xff\xff\x8a\xe5\x0f\x67\x49\x01\x00\x00"
-
-func postgresQuerytypeGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_postgresQuerytypeGoTpl,
-		"postgres.querytype.go.tpl",
-	)
-}
-
-func postgresQuerytypeGoTpl() (*asset, error) {
-	bytes, err := postgresQuerytypeGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "postgres.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _postgresTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5f\x6f\xda\x48\x10\x7f\x36\x12\xdf\x61\x6a\x55\xad\x69\xa9\x51\x1f\xee\xe1\x22\xf1\xd0\x06\xd2\x46\x4d\x21\x0d\xe4\x5a\xe9\x74\x2a\x0b\x1e\xc0\x17\xb3\x4b\x76\xd7\x09\x08\xf1\xdd\x4f\xbb\x6b\x1b\xdb\x18\xb0\xd3\xf4\x22\x1d\x5c\xcd\xfc\xdf\x99\xdf\xfc\xd6\x9b\xcd\x3b\x78\x29\xe6\x8c\x4b\x38\x6b\x83\xa3\xff\x8f\x92\x05\x82\xdb\x53\x9f\x36\x72\x6e\x83\xcd\x51\xd8\x60\x8b\xfb\x40\x48\xf5\x4f\x6f\x6c\x83\xfd\xa3\x7f\xc5\x66\x76\x03\xde\x6d\xb7\xf5\x9a\x36\x23\xc9\x38\x40\x63\x66\x32\xc7\x05\x01\x77\x10\x7d\x0f\xd5\x2f\xe6\x53\x99\x4d\x29\xf9\x53\x70\xcf\xd9\x62\x81\x54\x9a\x87\xad\x16\x6c\x36\xbb\x67\xb1\x1c\x06\x02\x33\x02\x3a\xbc\xed\x16\x38\x2e\x39\x0a\xa4\x52\x00\x01\xce\x1e\x61\xca\xd9\x02\x5e\x6f\x36\x71\x3c\xdb\xed\x6b\x37\xb2\x41\x3d\x6d\x4f\xae\x97\x98\xb1\x21\x24\x0f\x27\x12\x36\x46\x8c\x13\x3a\x43\x70\x2f\x7c\x0c\x3c\xa1\x15\xac\xb4\xf0\x66\x03\x1c\xb5\x09\x77\xa8\x3e\xb7\x5b\x18\xfd\x2b\x18\x3d\xb3\x4d\xdc\x81\xfa\x2f\x5c\xd0\x48\xde\x1e\x41\x92\x52\xee\xa7\x6c\x54\x71\x35\xae\xb9\xbf\x20\x7c\xfd\x05\xd7\xfa\x71\xbd\x66\xb5\x5a\xb0\x62\x30\xd5\xf1\xd4\x6b\xd6\x4f\x5c\xf9\x42\x8a\x26\xfc\xf4\x30\x40\x89\x1e\x8c\x19\x0b\x94\x81\x8c\xad\x38\x0d\xc6\xd1\x9f\xd1\x2f\xb8\xde\xe5\x32\x35\xcf\x74\x86\x3a\x16\x93\x6c\x9c\xe2\xc5\x17\x78\xa3\x02\xbe\xc1\xa9\xca\x30\xc9\x7c\x97\x66\x64\xa0\xf3\x31\xad\xbd\x9f\x3a\x78\xe3\x2a\xe2\xa3\x6c\x41\x74\xf2\x71\x55\x06\xf7\xc1\xca\xc4\xdf\x6a\x41\xeb\xb9\xfe\x8c\xb9\xf8\xef\x33\x06\x4b\xe4\x30\x0d\xe9\x44\xfa\x8c\x0a\x15\x37\xdc\x87\xc8\xd7\x3e\x9d\x41\x28\xd4\xa7\x9c\x23\x08\x15\x4b\xe0\x8f\x39\xe1\xeb\x67\x0f\xa8\x5e\xb3\x54\x00\xf0\x4d\xf9\x4d\xf5\x9d\x73\xaf\xfd\xba\xfa\x39\xf2\xa6\x09\x4c\xb5\xae\x4f\x67\x4d\x20\x7c\x26\xc0\x75\x5d\x9f\x4a\xe4\x53\x32\xc1\xcd\xb6\x01\xce\x9b\x94\x81\x26\x20\xe7\x8c\x37\x54\x9b\x5b\xd6\x03\xe1\xe0\xa1\x90\xb0\xd9\xb8\x49\x3b\x5a\x16\x72\xae\x06\x58\x7b\xfa\x84\xd2\xb9\x6f\xc2\x2b\x25\x16\xb9\x33\x7e\x5c\xd7\x6d\x28\x61\x8e\x32\xe4\x34\x16\x40\xce\xeb\x35\x6b\xbb\x9f\xc1\x84\xd1\x07\xe4\xb2\xb7\x43\x95\xed\x56\x3c\x29\x9d\xbf\xff\x29\x91\x90\x16\x3a\x94\xd3\x00\x03\x9c\x94\x4b\xeb\x44\x56\xb1\x87\xef\xbe\x9c\x9f\xcb\x95\x33\x91\x2b\x95\xa9\xc4\x95\x74\xcf\xcd\x77\x13\xb2\x49\xee\x1e\xff\x0f\x47\x17\x39\x53\x71\x35\xe1\xb7\x1d\xe3\xef\xca\xfe\xb9\x4e\xba\x72\x15\x72\x45\x48\xe3\x91\x46\xd8\x02\x84\x6e\xb5\xa0\xab\x11\x19\x3c\x94\xc8\x17\x3e\x45\xa1\xe4\x14\x54\xa4\x17\x87\x81\x6d\xf0\xa9\xfe\xc5\x23\x92\x8c\x89\x40\xb7\x5e\xd3\x65\x76\xd4\xc6\x32\x8b\x78\xbb\x85\x74\xf6\x8d\xc8\xbc\xd3\xd0\x40\xaf\x8b\x10\x45\x9b\xd6\x71\xa3\xbd\x10\x01\x67\xab\x05\x9d\x68\x3d\x2c\x39\x7b\xf0\x3d\x15\x14\x9d\x32\xbe\x20\x0a\xdc\x8a\x02\x9c\x13\x01\x63\x44\x55\x04\xa3\xa8\x57\x69\xd5\x60\x23\xaf\x27\xa3\x8d\x9c\xec\xc2\xbd\xa4\x02\xb9\x04\x5f\x7f\x89\xbd\xe8\x24\xab\x1c\x8a\xb1\xe8\x78\x63\xf8\xd1\xef\x7c\x6c\x98\x2e\xd2\x11\xa9\x1e\x52\xdd\xa2\x9f\xc4\x4b\xd6\x9f\x02\x09\x38\x12\x6f\x6d\xce\xaa\x09\x63\xe2\x07\xf5\x9a\xe5\x4f\x0b\x2b\x6d\xda\x31\x4a\x4e\x5b\x12\x6e\x0f\x1f\x1d\xdb\xa4\x00\x53\xe2\x07\xe8\x9d\x65\x8d\x0a\xbb\x11\xcf\x57\xd4\x4e\x86\x1c\x7d\x25\x34\x24\xc1\xf5\x5d\xb2\xe4\xc4\x7d\x10\xd5\x22\x6e\xd7\xa5\xe9\x3b\xb8\xc3\x35\x2c\x42\x21\x61\x8c\xf1\xd9\x7a\xf5\x9a\x35\x61\x54\x48\x30\x24\x0d\xda\x30\xba\xec\x0d\xba\x37\x43\xb8\xec\x0d\xfb\x90\xa6\x43\xe0\x8c\xe0\xad\x8a\x7c\xa4\xa7\x3a\x50\x74\x4f\xa4\xf8\x4e\xfc\x6b\x03\xfe\xfa\x70\x75\xdb\x1d\xe4\xe5\x1f\x48\x50\x28\x3e\x8a\xeb\xc8\x43\x6a\x42\xae\xd7\x2c\xcd\x12\x1d\x13\x54\x53\x85\xa1\x69\x4c\xd6\x65\x52\x58\x55\x99\x9f\x7a\xfa\xa0\x0d\xde\xd8\xed\xae\x70\x52\x45\xd7\x9f\x6a\xdd\x17\x6d\xa0\x7e\x90\x3f\x9d\x78\xa0\x0d\x95\x2c\x57\xe5\xb8\xba\x30\x5e\x83\xc0\xfb\x10\xe9\x04\x9f\xad\xd2\x29\x1c\x89\x5b\xb6\x52\xe9\x8f\xea\xdf\x74\x87\xb7\x37\xbd\xcb\xde\x27\xd8\xb9\xce\x68\x9c\xb3\x40\x29\xfc\xe2\x99\x15\xc4\xa0\x0e\x22\x39\x41\x8d\xfd\x37\xec\xf1\xe9\xd6\xdc\xc1\x84\x50\xe7\x55\x66\xfe\xd4\x90\x17\x3b\x2e\xd9\x01\x09\xa2\xeb\x16\x40\x69\x46\x33\x3a\xdd\xc2\x51\x6f\x83\xe4\x21\x6a\x95\xc8\x20\x55\xd0\x90\x9a\x63\x8a\xe0\xec\x52\x5b\x84\x81\xf4\x8f\xe4\x67\x7e\x68\x80\x6d\x27\xad\x78\xbb\xf4\x88\x44\x08\xf5\xd7\x3e\xfe\xed\xef\x0d\xeb\x24\x00\x1a\x93\x45\x00\x58\x80\x80\x11\x04\x7a\x0c\x05\x7d\x2d\x73\x10\xa8\x2a\xfb\xe2\x30\x08\x16\xa1\xa0\x49\x24\x41\x41\x65\x17\x28\x8b\x0c\x6b\x14\x8c\x68\x46\xec\xd8\x2c\x84\x8c\xcb\xc2\x95\x51\xda\xe5\x82\xf0\x3b\xb5\xc6\x18\x37\xc6\x7d\x46\x33\x7e\xcd\xc1\xcd\x24\x38\x10\x20\xdd\x3f\x1e\x68\xc0\xfb\x88\x5a\xc4\x58\x61\xc8\xcb\xa3\x2f\xe7\x30\x61\x8b\x25\x13\xbe\xc4\x34\x64\x68\xd9\x3c\x3e\xdc\x5e\x77\x3e\x0c\xbb\x59\x68\x18\x74\x87\xc9\x74\x67\x01\x22\xdb\x3a\xfb\x41\x25\x63\xae\x07\xbd\x0d\x0e\xe4\xcd\x28\x98\xa8\x66\xe5\xfb\xe7\xee\x4d\x37\x05\x15\x42\xe7\x19\xd9\xd8\xd3\xb5\xe1\x43\xaf\x03\x36\x38\x33\x94\x42\x12\x2e\x27\x2c\xa4\xf2\xb0\xb3\x86\x9e\xc6\x18\x6d\xac\x3c\xde\x58\xc7\x10\xa7\xdc\x20\x69\x92\x98\xc3\x96\x3d\x19\xa3\x6c\x80\xc2\x2a\xb9\x68\x7e\x97\xfb\x0c\x2a\x59\xc9\x1b\x8e\x82\x66\x7b\x86\x8e\x2a\xb1\x32\x8e\xf6\x52\x59\xfd\x7c\x17\x15\x2c\x1c\x68\xc3\x4b\x23\x70\xb0\x67\x12\xcb\x4f\xe8\x96\x12\x1b\xa5\x09\x25\x77\x49\xd9\x16\x79\x5e\x9f\x05\x8d\x11\x6d\x2b\x2b\xd9\x58\x03\xf2\x80\x20\xc8\x43\xc1\x96\xd8\x67\xc9\xa7\xb7\x84\x32\x57\xb8\x23\xf2\x00\x9c\xdc\x43\x32\x00\x9c\x11\x49\x36\x4e\x1a\x67\x8b\x04\x13\x6e\xde\x48\x67\x76\xbb\xd4\x54\x6c\x89\x5c\xdd\x54\x04\x10\x0a\xa1\x79\xa4\x40\x3c\x15\xb5\xab\xe5\x8d\x52\xaf\x3f\xec\x9e\xc1\x35\x13\x72\xc6\x71\xf0\xed\x0a\xfe\x74\xff\x78\x0b\x8c\x06\xeb\x72\x3b\xf2\xd0\x25\xe1\xf0\x8e\x2c\xbe\x26\x1c\xbf\x27\x3c\xed\xa2\x90\x5a\x91\x19\x44\xa8\x4c\x41\x4f\xb0\xfd\x02\xce\x79\x9c\xef\x2b\x85\x7e\x0f\xce\xfb\xbd\x8b\xab\xcb\xf3\xa1\x2e\xf1\xce\x7c\x01\x40\x36\xa0\xd3\x87\x08\xb7\x32\x50\x75\x3a\xb0\xf6\x9e\xf0\x92\xe3\xd4\x5f\x65\x55\xec\xee\x8f\xf3\xab\xdb\x4e\xb7\x63\x67\xb4\x47\x49\x01\x33\x28\x52\xf9\x62\xf2\x4b\x37\x93\x22\x62\x9a\x1b\xf5\xd4\x41\xe7\x08\xe9\x49\x46\x9a\xa7\xa4\xf9\x4b\x4e\xc4\x2c\x85\x24\x12\x17\xfa\x55\x39\x5b\xf8\x52\x31\x29\x2f\x44\x05\x19\x01\x99\xdc\x01\x9b\x46\xef\x98\x81\xc9\x39\x72\x90\x73\x42\xb3\xd4\x26\x43\x9d\x93\x17\x0c\x11\x71\xdb\xc7\xa2\x5f\x78\x7d\x50\xe5\xce\x5e\x4c\x58\x8f\xf3\xd5\x74\xc1\x76\xe8\x53\x40\x42\x8f\x73\xd0\x02\x33\x15\x38\x65\x05\x4a\x99\x1f\xf7\x4e\xf7\xaa\x3b\xec\xc2\xc5\x4d\xff\x6b\x76\xdc\x0f\x30\xb9\x23\x24\x2e\x59\xb4\x95\x26\xe4\x00\xbb\xa9\x3a\x2b\x27\xcc\x94\x9a\x9a\x3c\x7b\x3a\x05\x95\x07\x6b\x57\x92\xbe\xbc\x2f\x5b\xaf\x52\x0b\xff\x58\xa5\x4a\x19\xa8\x50\xa3\xbd\x6b\x6f\xf2\x0e\xce\x2a\x6e\xf2\xc3\xb7\xde\xac\xbd\xff\x02\x00\x00\xff\xff\xee\x17\xcc\xed\x46\x1c\x00\x00"
-
-func postgresTypeGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_postgresTypeGoTpl,
-		"postgres.type.go.tpl",
-	)
-}
-
-func postgresTypeGoTpl() (*asset, error) {
-	bytes, err := postgresTypeGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "postgres.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sqlite3ForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\x06\xf2\x1f\xde\x41\xd8\x64\xd9\xed\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x24\xa9\x5a\x42\xfe\xbb\x24\xed\x76\xeb\xd2\x43\x20\x7c\xf3\x66\xe6\xbd\xf1\x7e\x8b\x6b\x5b\x6b\xe3\x70\x73\x0b\x91\x7e\x2a\x6f\x09\xd9\x5b\xff\x45\xd9\x73\xde\x92\xc4\x36\x04\xce\x76\x3b\x78\x8f\x44\x10\x02\x0c\xb9\xce\x28\x0b\x57\x53\xe2\xaf\x54\x4d\x1d\xb1\x9e\x5b\xab\x8b\x26\x77\x54\xe2\xa7\x71\xf5\xa4\x9b\x8b\x56\x36\xa1\x87\x86\x8e\xe5\xd4\x28\xce\xe8\x4e\x1f\xe3\xeb\x5a\x35\x16\x65\x16\x7d\x24\x2f\x8f\xa4\xc8\xa4\xf1\x95\xd1\x2d\x2a\x6d\xa8\xf9\x50\xf8\xa4\x1e\xab\x34\x61\x00\x4f\xd4\xcf\xbe\xa7\xbd\x19\x67\x55\xa7\x8a\xb4\x6b\x4c\x1f\x02\xd6\x97\xfe\xe4\x3c\xb1\x28\x0f\x78\x7f\xb9\xdf\x4b\x88\xf5\x42\xe0\x0d\xc8\x18\x6d\x24\x3c\x67\x57\xc3\x71\x96\xee\xb2\xef\x47\xf8\x2f\xb4\x28\x0f\x9b\xa8\x2e\xb4\xfa\xa6\x5f\x77\xf2\x34\x9c\xe1\x2c\x8f\x96\x38\x0b\x9c\x71\xf6\x17\x00\x00\xff\xff\x59\x1b\xe5\x5b\xb8\x01\x00\x00"
-
-func sqlite3ForeignkeyGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_sqlite3ForeignkeyGoTpl,
-		"sqlite3.foreignkey.go.tpl",
-	)
-}
-
-func sqlite3ForeignkeyGoTpl() (*asset, error) {
-	bytes, err := sqlite3ForeignkeyGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sqlite3.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sqlite3IndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x61\x4f\xdb\x30\x10\xfd\x9c\x48\xf9\x0f\x37\x6b\x82\x74\x2b\xe9\x77\xa4\x7e\xd8\xa0\x6c\xd3\x18\x6c\xc0\x34\x24\x84\x56\x37\xb9\x40\xa4\xd4\x6e\x6c\x07\xa8\x2c\xff\xf7\xe9\xec\x34\x94\x52\x6d\xb0\x2f\xc6\xb1\x7d\xef\xde\xbd\xf7\xa8\xb5\x7b\xf0\x56\xdf\x4a\x65\x60\x7f\x0c\xa9\xdf\x09\x3e\x47\xc8\x2e\x96\x0b\xcc\x4e\x68\xcb\x50\x29\x06\x4c\x37\xb5\x36\xb4\x29\x66\x0c\x58\xc3\x80\x29\xd4\x0c\xd8\xe5\xe9\xb1\xbc\x61\x90\x1d\x55\x58\x17\x7a\x00\x7b\xce\x25\xb1\xc7\x35\x7c\x56\x63\xc0\xcd\x6f\x71\xce\x21\x3b\xef\xfe\x7a\xf0\x0b\xba\x0e\x2b\xf5\xe9\x2a\x47\x23\xb0\x16\xb2\xa3\x56\xe4\xbe\xbb\x73\xa0\xd0\xa8\x0a\xef\x50\x03\x07\x25\xef\xa1\x54\x72\x0e\xbb\xd6\xae\x3a\x38\xb7\x0b\x9c\x2e\xa9\xf0\x91\xb7\x73\x19\xc1\x79\xc8\x4f\x28\x50\x71\x83\x45\x28\xae\x44\x81\x0f\x1e\x22\xfb\x42\xdb\xb0\x76\x55\xbb\x59\x12\x97\xad\xc8\x37\x79\xa4\xc5\x0c\x2e\x4f\x0f\x3f\x5a\x0b\x37\x72\xc1\x15\x9f\xd7\x95\x36\xab\xc1\xc1\xa8\x16\xc3\xe2\xdc\x00\x52\x6b\xa1\x2a\x41\x48\xd3\xb7\xd0\x3f\x45\xd5\xf8\xeb\xab\x6b\x6b\x01\x45\x01\xce\xbd\xdb\xe4\x3c\x04\x54\x4a\xaa\x01\xd8\x24\x8e\xee\xb8\xa2\xcf\x70\x94\xc4\x49\x1c\x8d\x46\xa0\x9b\x1a\x9a\x16\xd5\x32\x89\xa3\x5c\x0a\x6d\x20\x58\x03\x63\x98\x9e\x4f\x8e\x27\x07\x17\x30\x85\xf7\x49\x1c\x45\x53\x6b\x21\x97\x35\x19\xaa\xbb\x2e\x1d\x59\xe7\xfa\x37\x47\x67\xa7\xdf\x60\x5d\xcc\xfe\xe6\xd7\xe7\xc9\xd9\x04\xd6\x30\x7c\xd7\x7e\x60\x06\x1f\x4e\x0e\x81\x81\x73\xd3\x15\x35\xd5\x8a\x9e\x9a\xcf\x45\x1a\xa8\xfd\x4d\xb2\x92\xd7\xda\x6b\x16\x52\x53\x95\x5b\x04\x4b\xe2\x88\x18\x86\xa0\x3a\x47\x99\xda\xd4\xcd\xfa\x37\xa1\xde\x9f\x7f\x57\xd5\x9c\xab\xe5\x57\x5c\x06\x80\xe8\x37\x3e\x54\xda\xe8\x7d\xdf\x76\x18\x20\xc9\x04\x1f\xbb\xc8\xf9\x19\x48\xec\x31\x14\xb3\xec\x07\x4d\x71\x26\xef\x5f\x33\x40\x76\x9e\x73\x41\xc6\x97\x74\xbb\x45\xf5\x74\xa1\x2a\x61\x80\xed\xb0\x6e\x96\x41\x98\x3b\xaa\x4a\x6f\xf3\x9b\x31\x88\xaa\xf6\xce\x47\x0a\x4d\xab\x04\x7d\xfb\x44\xf4\x0c\xbb\xf3\x9d\x75\x3d\x86\xf4\x2c\xc8\x87\x81\x4b\x12\x47\x8d\xaf\x23\xa9\x56\xe3\xbc\xce\x8c\x17\x93\x8a\x0a\x2c\x51\x41\x93\x1d\xd4\x52\x63\x3a\x58\x85\xa1\x96\xbc\x00\x85\xba\xad\x8d\xf6\xc4\x35\x91\xb9\xba\x7e\x16\x79\x4b\x20\xa5\x24\x88\x13\x7c\x30\x69\xc8\xfe\x8b\x1c\xff\x87\xe5\xcf\x3d\x7f\x6a\x7a\xa7\xa9\xff\xaf\xca\xb9\xa0\x6d\x48\x40\xf3\xff\x5e\x6e\xd3\x6d\x8b\x70\xab\xd6\x24\xcb\x18\xf8\x62\x81\xa2\x48\x15\xea\xe1\x53\x6b\x07\x9b\xc6\xfb\x27\x8f\x76\xfb\x1f\x91\x24\xf6\x4f\xfe\x04\x00\x00\xff\xff\xf2\x9f\x01\xf4\xcd\x05\x00\x00"
-
-func sqlite3IndexGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_sqlite3IndexGoTpl,
-		"sqlite3.index.go.tpl",
-	)
-}
-
-func sqlite3IndexGoTpl() (*asset, error) {
-	bytes, err := sqlite3IndexGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sqlite3.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sqlite3QueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x6a\xdc\x3c\x10\xbd\xb6\xc1\xef\x30\x9f\x58\x82\xfd\xd5\xf1\xde\x07\x7c\xd3\x94\x42\xa1\x64\xfb\x77\x11\x08\x81\x6a\xd7\xf2\xd6\xa0\x95\xd6\x92\x9c\x66\x11\x7a\xf7\x32\x92\x7f\x37\x1b\x4a\x73\x27\x0f\x47\x47\xe7\xcc\x99\xb1\xb5\xd7\xb0\xd2\xbf\xa4\x32\x70\x53\x42\xea\x4f\x82\x1e\x18\x14\x3f\x4e\x47\x56\xdc\xe1\x91\x30\xa5\x08\x10\xdd\x72\x6d\xf0\x50\x6d\x09\x90\x96\x00\x51\x4c\x13\x20\xf7\x9b\xcf\x72\x4f\xa0\xf8\xda\x31\x75\xfa\x42\x15\x3d\xe8\x0c\xae\x9d\x4b\x62\x4f\xde\x62\xf9\x56\x1e\x0e\x4c\x18\x8d\x8f\x04\xe0\x58\x19\x91\x4d\x0d\x45\x5f\x0d\xc5\xf5\x1a\xac\x9d\x6a\x03\x8e\x71\xcd\x16\x00\x2f\xd2\x39\x50\x9d\xd0\x40\x61\xd7\x69\x23\x0f\xe0\xdf\xcd\x41\x31\xd3\x29\xd1\x88\x3d\x28\xa6\x3b\x6e\x34\x50\xed\x6f\x4d\xfe\x9c\x2b\x7a\x66\x51\xf9\x57\xea\x4e\xec\x16\xcc\x69\xb5\x85\xfb\xcd\x87\xf7\xd6\x82\xa2\x62\xcf\x16\x66\xc1\xb9\x7c\x81\x1e\xd8\xc1\x39\x6b\x7b\xd2\x0c\x52\x6b\xd1\xa2\x90\x06\x8a\x8d\xe0\xa7\x8d\x40\xc0\xc3\xe3\x08\xf9\xff\x5c\x55\x0e\x4c\x29\xa9\x32\xb0\x49\x1c\x3d\x51\x85\x9f\xa1\x94\xc4\x49\x1c\xad\xd7\xa0\x5b\x1e\x7c\x26\x71\x14\xe8\x8b\x4f\xc2\x30\x75\x94\x9c\x1a\xa4\x78\xa2\x0a\xf9\xb1\x63\xce\xed\xa4\xd0\x66\x7c\x0e\x42\x9e\x50\xc2\xe8\x6a\xd5\xe4\xb0\xe2\x53\x46\xc1\x40\x53\xc3\xaa\xc1\x0b\xef\xc6\xbb\xa1\x9a\x36\xa2\x62\xcf\xe7\x09\xaf\x9a\x0c\xc1\x21\x9b\x57\x10\xf3\xce\xcc\x5e\xf0\x2e\xb0\x7a\xed\xdc\x4f\x6b\x51\x4b\x38\x0c\xc1\x04\xd7\xaa\x13\xa3\x6b\x3f\x7c\x69\xb0\xf2\x5a\x3a\xb3\xc6\x2f\xbb\xb3\x88\x6d\x2e\xa8\xcf\x6c\x9a\xcb\x29\xb0\x3e\x0a\x54\x17\xd6\x66\x16\xf8\x40\x95\xc4\x11\x46\x55\x42\xb5\x0d\x52\xbe\xc9\xdf\x7f\xd1\x78\x59\x4a\x56\x7c\xdf\x51\x81\x93\x53\x37\x8c\x57\xb8\x98\xba\x7f\xea\x23\x16\x34\xa4\x47\xd5\x08\x03\xe4\x8a\xf4\x7a\xb2\x20\x3c\x6a\x6a\x3f\x2e\xff\x95\x20\x1a\xee\x27\x28\x0a\xab\x80\xdf\x7e\xb2\x92\x38\x0a\x2d\xed\xeb\x57\x73\x4f\x39\xc2\x66\xfb\xe6\x4d\xb5\xfe\x1e\xce\xc7\x60\xec\x6d\xae\xfe\x41\x5e\x54\xb1\x9a\x29\x68\x8b\x5b\x2e\x35\x4b\xb3\x61\x06\xb8\xa4\xd5\xb0\xd1\xde\x82\xff\xb5\x3c\x3c\xbe\x58\x22\x8b\x24\xb5\x44\x8a\x3b\xf6\x6c\xd2\xb0\x4d\xd1\x22\xbf\x9b\xf2\x45\x84\x36\xb4\xc6\x2f\xd9\x8e\x0a\x3c\x86\x48\xdb\xb7\x47\x72\xc9\xf4\x05\xd7\x7d\x2a\xde\x53\x09\xf4\x78\x64\xa2\x4a\x15\xd3\xf9\x32\xa1\xec\x3c\x3f\x0f\x99\x52\xeb\x57\xc6\x43\xfe\x04\x00\x00\xff\xff\x9b\xab\xcf\x10\xe6\x05\x00\x00"
-
-func sqlite3QueryGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_sqlite3QueryGoTpl,
-		"sqlite3.query.go.tpl",
-	)
-}
-
-func sqlite3QueryGoTpl() (*asset, error) {
-	bytes, err := sqlite3QueryGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sqlite3.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sqlite3QuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8f\xcf\x4a\xc4\x30\x10\xc6\xcf\x5b\xe8\x3b\x7c\x2c\xc2\xea\x61\xb3\xf7\x05\x4f\x82\x47\x2f\xf6\x01\x1a\xdb\xa9\x56\xf2\xa7\x24\x29\x22\xc3\xbc\xbb\xa4\x69\xd5\xee\x21\x13\x98\xf9\xcd\x8f\x6f\x98\xcf\xb8\x4b\xfa\xcd\x10\xae\x8f\xb8\x8f\xdd\x07\x59\x0d\xf5\xba\xfe\x4d\x9e\x94\xfa\xa2\x2d\x3d\xe0\x2c\x52\x57\x79\x69\x1c\xa0\x9e\xbc\xb5\xe4\x52\x69\x5e\x2e\x60\xfe\xeb\x6d\x1c\x99\x48\x3b\x20\x7b\x20\x82\x40\x53\xa0\x48\x2e\x45\x68\x04\xff\x85\x21\x78\x8b\x13\xf3\x96\x47\xe4\xa4\x56\x87\xeb\x17\x5f\xfa\x9e\x68\xe7\x88\x29\xcc\x5d\x02\x17\x2c\x68\xf7\x4e\x50\xcf\x23\x99\x3e\x2e\x0b\x87\xff\x30\x33\x02\x2d\x0a\xd5\xe4\x2a\x82\xf6\x33\x7a\x77\x3d\x96\xdc\x26\xbf\xd9\xba\x95\x3f\xb6\xf8\x3d\xe9\x66\x74\xd8\xc7\x92\xba\xaa\xab\x9f\x00\x00\x00\xff\xff\x8a\xe5\x0f\x67\x49\x01\x00\x00"
-
-func sqlite3QuerytypeGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_sqlite3QuerytypeGoTpl,
-		"sqlite3.querytype.go.tpl",
-	)
-}
-
-func sqlite3QuerytypeGoTpl() (*asset, error) {
-	bytes, err := sqlite3QuerytypeGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sqlite3.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sqlite3TypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x98\xff\x6f\xda\x46\x14\xc0\x7f\x36\x12\xff\xc3\xab\x35\xad\x76\x47\xcd\xf6\x6b\xa4\x68\x6a\x13\xba\x46\x4d\x93\x2e\xd0\xb5\xd2\x34\x95\x03\x3f\xe0\x16\xfb\x8e\xdc\x9d\x29\x08\xf1\xbf\x4f\x77\x67\x1b\x9f\x71\x08\xa4\xc9\x22\x0d\x56\xfb\xdd\xfb\xee\xcf\x7b\x66\xbd\x7e\x0d\x3f\xc9\x19\x17\x0a\x4e\x4e\x21\x30\xff\xc7\x48\x8a\x10\x5d\xe9\x4f\x1f\x85\xf0\xc1\x17\x28\x7d\xf0\xe5\x5d\x22\x95\xfe\x67\x3c\xf2\xc1\xff\x7a\x7d\xc9\xa7\x7e\x08\xaf\x37\x9b\x76\xcb\xa8\x51\x64\x94\xa0\x55\x33\x9e\x61\x4a\x20\xea\xe7\xdf\x03\x7d\xc7\x7e\x6a\xb5\x95\x43\x74\x02\xd1\x19\x4f\x53\x64\xca\x5e\xec\x76\x61\xbd\xde\x5e\x2b\xe4\x30\x91\xe8\x08\x18\xf7\x36\x1b\x10\x38\x17\x28\x91\x29\x09\x04\x04\xff\x0e\x13\xc1\x53\x78\xb9\x5e\x17\xfe\x6c\x36\x2f\xa3\x5c\x07\x8b\x8d\x3e\xb5\x9a\xa3\xa3\x43\x2a\x91\x8d\x15\xac\xad\x98\x20\x6c\x8a\x10\xbd\xa3\x98\xc4\xd2\x1c\xf0\xaa\xc2\xeb\x35\x08\x34\x2a\xa2\x81\xfe\xdc\x6c\x60\xf8\xaf\xe4\xec\xc4\xb7\x7e\x27\xfa\xbf\x2c\x65\xb9\xbc\x3f\x84\x32\xa4\xda\x2d\xd7\xab\x22\x1b\x9f\x04\x4d\x89\x58\x7d\xc0\x95\xb9\xdc\x6e\x79\xdd\x2e\x2c\x39\x4c\x8c\x3f\xed\x96\xf7\x0d\x97\x54\x2a\xd9\x81\x6f\x31\x26\xa8\x30\x86\x11\xe7\x89\x56\xe0\xe8\x2a\xc2\xe0\x02\xe9\x94\x7d\xc0\xd5\x36\x96\x89\xbd\x66\x22\x34\xbe\xd8\x60\x8b\x10\xdf\x7d\x80\x57\xda\xe1\x1b\x9c\xe8\x08\xcb\xc8\xb7\x61\xe6\x0a\xce\xdf\x56\x4f\xef\x86\x0e\xf1\xe8\x18\xf1\xa1\x9b\x10\x13\x7c\x91\x95\xfe\x5d\xb2\xb4\xfe\x77\xbb\xd0\x7d\xaa\x3f\xab\xae\xf8\x7b\x8f\xc9\x1c\x05\x4c\x32\x36\x56\x94\x33\xa9\xfd\x86\xbb\x0c\xc5\x8a\xb2\x29\x64\x52\x7f\xaa\x19\x82\xd4\xbe\x24\x74\x24\x88\x58\x3d\xbd\x43\xed\x96\xa7\x3d\x80\x3f\xb5\xe1\x4a\xe3\x05\x77\xc6\x70\x64\xae\xa3\xe8\x58\xcf\x74\xef\x52\x36\xed\x00\x11\x53\x09\x51\x14\x51\xa6\x50\x4c\xc8\x18\xd7\x9b\x10\x82\x57\x15\x05\x1d\x40\x21\xb8\x08\x75\x9f\x7b\xde\x82\x08\x88\x51\x2a\x58\xaf\xa3\xb2\x1f\x3d\x0f\x85\xd0\x4f\xb0\xb1\xf4\x07\xaa\xe0\xae\x03\x3f\x6b\xb1\xdc\x9c\xb5\x13\x45\x51\xa8\x85\x05\xaa\x4c\xb0\x42\x00\x85\x68\xb7\x3c\xdb\xb2\x4e\x04\x63\xce\x16\x28\xd4\xd5\x16\x2b\x9b\x8d\x7c\x54\x38\x7f\xff\x73\x40\x40\x46\xe8\xbe\x98\xfa\x98\xe0\xf8\xb0\xb0\x1e\x88\xaa\xb0\xf0\x85\xaa\xd9\x99\x5a\x06\x63\xb5\xd4\x91\x2a\x5c\xaa\xe8\xcc\x7e\x77\xc0\x0d\x72\x7b\xf9\x7f\x28\x5d\x6e\x4c\xfb\xd5\x81\x67\x2b\xe3\x73\x45\xff\x54\x95\x3e\x3a\x0b\xb5\x24\x54\x81\x64\x10\xdb\x80\xe8\x6e\x17\x7a\x06\xc9\x10\xa3\x42\x91\x52\x86\x52\xcb\x69\x56\x54\x27\x87\xe5\x36\x50\x66\xee\xc4\x44\x91\x11\x91\x18\xb5\x5b\x26\xcd\x81\x1e\x59\x76\x12\x6f\x36\x50\x8d\x3e\xcc\xd5\x07\xa1\x21\xbd\x49\x42\xee\x6d\xf5\x4c\x94\x0f\x86\x9c\x9c\xdd\x2e\x9c\xe7\xf3\x61\x2e\xf8\x82\xc6\xda\x29\x36\xe1\x22\x25\x9a\x6e\x4d\x0e\xce\x88\x84\x11\xa2\x4e\x82\x3d\x68\x66\xe9\xb1\xce\xe6\x56\x1f\xf4\x36\x37\xb2\x75\xf7\x82\x49\x14\x0a\xa8\xf9\x92\x3b\xde\x29\x7e\xb4\x2b\x56\x63\x10\x8f\xe0\xeb\xf5\xf9\xdb\xd0\x76\x91\xf1\x48\xf7\x90\xee\x16\x73\xa5\x98\xb2\x74\x02\x24\x11\x48\xe2\x95\xad\x55\x07\x46\x84\x26\xed\x96\x47\x27\x8d\x99\xb6\xed\x98\x07\x67\x34\xc9\xe8\x0a\xbf\x07\xbe\x0d\x01\x26\x84\x26\x18\x9f\xb8\x4a\xa5\x1f\x16\xcf\x57\xd9\x50\x76\x3f\xfa\x48\x58\x46\x92\x4f\xb7\x50\x0e\x3a\x79\x97\xe4\xe9\x28\x3a\x76\x6e\x5b\x0f\x6e\x71\x05\x69\x26\x15\x8c\xb0\x28\x6f\xdc\x6e\x79\x63\xce\xa4\x02\xbb\xa8\xc1\x29\x0c\x2f\xae\xfa\xbd\x9b\x01\x5c\x5c\x0d\xae\xa1\xba\x12\x41\x30\x84\x5f\xb4\xf3\x43\xf3\x60\x27\x7a\xe5\x93\x95\x9d\xa7\xb8\x1b\xc2\x5f\x6f\x2e\x3f\xf7\xfa\x75\xf9\x05\x49\x1a\xc5\x87\x45\x2a\x45\xc6\xac\xcb\xed\x96\x67\x36\xc5\xc0\x3a\xd5\xd1\x6e\x98\x55\xc6\x35\x59\xe6\x56\x27\xe7\x9b\x79\x00\xe1\x14\xe2\x51\xd4\x5b\xe2\xf8\x98\xb3\x74\x62\xce\xbe\x38\x05\x46\x93\x7a\x81\x4a\xb0\xe9\xdc\xa2\xb2\x05\x41\x36\x46\xbb\x17\xed\x16\xf8\x14\x94\xc8\xd0\xee\x56\x7a\x03\x3d\xac\x30\x45\x41\x60\xb4\x02\x92\x29\x4e\xd9\x58\xa0\x5e\x67\x9f\xac\x42\x15\x04\x15\xdd\x7e\x54\xc9\xf6\x9d\xff\xd1\x1a\x36\xe8\x0e\x0d\x03\xa4\x2d\xeb\xc9\x91\x75\xbd\x47\xdf\xc1\x85\x16\xa8\x04\xc5\x05\x02\xd5\x4f\x08\x8d\x4b\x2f\x04\xca\xe8\x92\x48\x65\x29\x71\x11\x07\x47\xf7\x4f\xb5\xe8\x84\xc5\xf7\xf7\x93\xa6\xd2\x6e\x18\x70\x0a\xb5\x1b\xf9\x1b\x45\x40\xe3\xf0\x90\x96\x2c\x07\x53\xe1\x23\xd3\xb8\xaa\x8c\x2a\x86\x10\x6c\x33\x9b\x66\x89\xa2\x7b\xd2\x6b\x6f\x84\xe0\xfb\x65\xa3\x7f\x9e\xc7\x44\x21\x64\xe6\x6b\x97\xc9\xbb\xb3\xcc\x7b\x10\xca\x56\x65\x13\x94\x1b\xa8\x9c\x63\x39\xe6\x28\xd9\x4b\x55\xc3\xb2\x2e\xd6\x8b\xfb\xc1\xdc\x44\x66\x1b\x48\x49\x66\xad\x17\x18\xcf\x15\x1b\x32\xe7\x15\x2e\x0c\xdb\x21\xe5\x98\x6c\x1c\x63\x07\x9b\x4c\x89\xb8\xd5\xa3\x95\x0b\xab\x9c\x72\xe6\xd8\xb5\x85\x9b\x2a\x08\x20\x41\xb6\x5b\x1e\x08\xe1\xb7\x7c\xdd\x29\x48\x64\x17\xaa\xef\x54\xcd\x60\xcc\xd3\x39\x97\x54\x61\xb5\x37\x8d\x6c\x1d\x3c\x9f\x3f\x9d\xbf\x19\xf4\x5c\xe6\xf4\x7b\x03\xc8\x39\xe0\x82\xc7\x58\x70\xdb\xc7\xef\x80\x0f\xbf\x36\xf8\x57\xa2\xc4\xf3\x86\xf0\xe5\x7d\xef\xc6\x18\x71\x34\x35\x9c\xf2\xe1\xcd\xd5\x39\xe8\xc6\xb3\xfc\xf1\xea\x04\xf2\xf6\x31\xe8\xb0\xce\x36\x9b\x64\x8d\x35\x3b\x32\xf6\xb0\x65\x8c\x77\xe0\x28\x7a\x36\xf3\x0e\x7a\xbc\xf2\x87\x90\x86\xf2\x3f\x51\x8d\xdd\xf2\xde\x3f\x26\x9a\x6a\xeb\x88\x9f\xf1\xc4\x12\xee\xf7\xc7\xd4\xf3\x80\x19\xd0\x81\x03\x08\x7b\x4c\x11\x9f\xd6\x66\x43\xe5\x72\x5a\x6f\x47\x48\x9f\x2c\x10\x24\x59\x34\x80\x75\x77\xd9\x7d\x18\xac\x5a\x5d\x23\x56\xeb\xcc\x2a\x5f\x27\x1c\x66\x39\x22\x25\xa4\xab\x68\x6a\x12\x2c\x57\xec\xb0\x78\x61\x72\x37\xa5\x7c\x80\x48\x45\x94\x59\x81\x24\xf0\x94\x2a\x0d\xcc\x38\x43\x1d\x66\x42\xc6\xb7\xc0\x27\xf9\xef\x5b\xc0\xd5\x0c\x05\xa8\x19\x61\x2e\xc1\x9c\x71\x57\xbe\xdb\xe4\x7c\xde\xcd\xdf\x0f\xbc\xb9\x1c\xf3\xba\xd0\x3c\x97\xf6\x8f\xa5\xea\xa8\xde\xf6\x42\xc3\xac\xd9\x3f\x6a\x1a\xd4\x1c\x31\x3a\x8e\x98\x1c\x75\xa8\x9c\xf7\x2e\x7b\x83\x1e\xbc\xbb\xb9\xfe\xe8\x92\xe5\xf1\xac\xaf\xa3\x61\x2f\x19\xee\x41\x66\xf1\xe4\x1d\xfa\xb0\x3f\xa0\xa6\x61\x0f\xac\x3d\xd3\xf6\xc7\x54\x87\xc8\x75\x20\x3f\x3a\x75\xfb\x51\xfa\x60\xba\x0e\x42\xd4\xbe\x44\x1d\xa4\xe0\x88\x14\x6d\x17\xd5\x7c\x73\x2e\x5f\xfe\xbd\xe6\x16\x2f\xb6\xdc\xdd\xd5\xd6\xd5\xf7\x5f\x00\x00\x00\xff\xff\x19\xd2\x6c\x8d\xc0\x18\x00\x00"
-
-func sqlite3TypeGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_sqlite3TypeGoTpl,
-		"sqlite3.type.go.tpl",
-	)
-}
-
-func sqlite3TypeGoTpl() (*asset, error) {
-	bytes, err := sqlite3TypeGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sqlite3.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _xo_dbGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x94\x51\x6f\xdb\x36\x10\xc7\x9f\x63\xc0\xdf\xe1\x22\x6c\xa8\x94\xaa\xf2\xb2\xc7\x00\x7e\x58\xd7\xbe\x0c\xdb\xba\x39\xc5\x50\xc0\xf2\x60\x9a\x3a\xd9\x44\x24\xd2\xe1\x51\x72\x0c\xc3\xdf\xbd\xe0\x51\x72\xa4\xb4\xe9\x4b\x62\x51\xf7\xbf\xe3\xfd\xfe\xa7\x9b\xcd\xe0\xcb\xa7\x0f\xef\x41\x11\xb8\x1d\x82\x34\x75\x6d\x34\x28\xed\xd0\x96\x42\x22\x94\xc6\x42\x21\x9c\xd8\x08\x42\x30\x7b\xb4\xc2\x29\xa3\x7d\xb0\x70\x20\x85\x86\x0d\x42\x43\x58\xc0\x41\xb9\xdd\x74\x32\x9b\x81\x3b\xee\x91\xa0\xb4\xa6\x06\x92\x3b\xac\x05\xbc\x39\x9d\xfa\x9f\xd9\x7d\xf8\x7f\x3e\xbf\xc9\x7c\x34\x2b\x3e\xef\x14\x01\xed\x4c\x53\x15\x70\x30\xf6\x81\x73\x5d\xaa\xce\xe8\xb1\xca\x3e\xbc\x07\xa1\x8b\xf1\xd9\xe7\xa7\x6c\x3a\xf1\xd5\xba\x0e\x2e\x77\x3e\x4d\x27\x57\x1f\x9f\x50\xc6\xe4\xac\xd2\xdb\x14\xb2\x2c\xbb\xbc\x3d\x9d\x13\x88\xbd\x7c\x81\xd4\x54\x2e\x05\xb4\xd6\xd8\x64\x3a\xb9\xfa\xb7\x41\x7b\x7c\x5d\x73\xc3\x22\x73\xa0\x97\x92\x85\x39\xbc\xaa\xea\x45\xd3\xc9\x79\x3a\xe1\x66\xbf\x7c\xfa\xd3\x6c\x61\x6f\x4d\xab\x0a\x0c\xd0\x2b\xb3\x85\xb2\xd1\x32\x80\xdc\x1c\x61\x8b\xda\x83\xc6\x02\x1e\x1b\xb4\x0a\x29\x9b\x4e\x5a\x61\x3b\xed\x9c\x83\x5f\x2d\x79\x82\xbe\xd4\xbd\x14\x5a\xa3\xfd\x4f\x54\x0d\xda\x1f\x3a\x1c\x3c\x63\x4f\x55\xbd\xaf\xb0\x46\xed\x60\x63\xdc\xce\x4b\x38\xd7\x88\x7c\x97\x98\x2d\xa1\xc7\x6a\x56\x58\xd5\xa2\xcd\xfa\x42\x7d\x6a\xea\xfd\x79\x71\x91\x91\x51\x83\x7c\xd3\xc9\xd5\x28\xd3\x33\xb4\x7b\xee\xf5\xbe\x52\x12\x7d\x1f\x02\x88\x7f\x9a\x12\x02\x85\xe7\x4a\x83\xc0\xe5\x2a\xbc\xec\x72\x3c\x36\xc6\xe1\x47\x92\x62\x8f\x0b\xdc\xe2\x53\x0f\xc4\xf2\x83\x33\x50\x0b\x27\x77\x80\x1c\x51\x80\xdc\x09\x2b\xa4\x43\x4b\xa0\xb4\xaf\xc8\xb9\x3a\x23\xbe\xc9\x35\x0f\x69\xf6\xd9\x5f\x0d\xb9\xdf\x4d\xbd\x57\x15\xc6\xeb\x78\xf9\x7f\x9e\xaf\xe2\x65\x9e\xaf\x4e\xbf\x9e\x93\x9b\x24\xcf\xa3\x75\x32\x70\x07\x48\x38\x45\xa5\xea\x06\x61\xc8\x76\x6c\xd0\xa0\xaf\x6c\x3a\xe1\x61\x89\x89\xe0\x66\x70\x9e\x70\xc6\x98\xac\x84\xd1\x3c\xf0\xb0\x32\xea\x4d\x53\xa6\x60\x1e\xe0\x6e\x0e\x64\x65\x16\x2f\x57\x9b\xa3\x43\x3f\xc7\xaa\x84\x6b\xf3\xc0\x41\x57\x16\x5d\x63\x75\x90\x51\xf6\x37\x1e\xe2\x48\xe9\x56\x54\xaa\x18\xde\x22\xf2\x32\xb6\xe7\x6a\x36\xf3\xb0\xf4\x16\x03\x96\x8e\x20\xf1\xbd\x25\xb5\xb0\x17\x96\xd8\x5c\x72\xd6\xd7\x7e\x09\x2f\x5b\xe0\xbe\x12\x12\x7f\xab\xaa\x50\xa0\x1b\xed\x78\xd3\x94\x49\x0a\xeb\x9f\x6e\x23\xa6\xc6\xfa\xf9\xc5\xf1\x4e\xe5\x83\x53\x58\xe7\xf9\xda\xff\x5d\xa7\xf0\xee\x36\xe9\xaf\x65\xb1\x36\x2d\xc2\xc6\xfa\x61\x1c\x26\x58\xde\xde\x55\xa8\xbd\x34\x79\x77\xbb\xea\xc3\x37\x42\x55\xa0\x4a\x30\xba\x3a\x82\xd1\x18\xc0\xf4\x81\x30\x9f\xc3\x2f\x01\xd1\x0d\x11\xcc\x87\x34\xe2\x7e\xd6\x4e\xe7\x64\xc0\x50\xab\x6a\x48\x89\x41\x84\xe5\xe6\xb9\x58\x14\x05\x73\x91\x8c\x45\x52\xeb\x69\x2f\xf8\x34\xee\xbb\x1c\x9d\x24\x4c\xc1\x17\xe4\x1d\xc4\x2a\x9b\xf9\xf7\x71\xe7\xa2\x3f\xbd\x9e\xfb\xc2\xe1\xa6\x65\xed\xb2\x7f\xac\xd2\xae\x8c\x23\x7c\x52\x4e\xe9\xed\xf5\x1d\xfc\xdc\xe6\x3a\xe2\x14\xc9\xd8\xf1\xcb\x6d\xbf\xed\x90\xcb\x06\xb2\xc3\xee\xfa\x2f\x94\xbf\xd8\x17\xe3\xfc\xca\x5a\xf8\xd1\x40\x8f\xe6\x99\x85\x71\x02\xf1\x30\x51\xbf\x7d\xb9\xbf\xd6\x23\xa8\xc5\xc3\xb3\x01\x69\xf0\x8b\x18\x95\x2f\xa4\x52\x20\x1f\x65\x79\x42\x89\x02\x97\x76\xa9\x56\x30\x87\x75\xb4\x86\xb7\xdf\x9b\xa8\xf1\x73\x37\x59\xeb\x3c\xef\x06\x2c\xf5\x4a\x7f\x10\x85\x67\x78\xeb\x0f\x02\xbd\x1e\x4f\x74\x8a\x06\xb9\xff\x30\x4a\xc7\x6d\x0a\x51\x1a\xf9\xe8\xe8\x1c\xa5\x63\x80\xdf\x5b\x6e\xa3\xbd\xf9\xbc\xe3\xba\xed\x36\x7a\xeb\xb3\x7c\x0d\x00\x00\xff\xff\xb9\x1c\x77\x75\xcc\x07\x00\x00"
-
-func xo_dbGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_xo_dbGoTpl,
-		"xo_db.go.tpl",
-	)
-}
-
-func xo_dbGoTpl() (*asset, error) {
-	bytes, err := xo_dbGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "xo_db.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _xo_packageGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8d\x41\x6a\x03\x31\x0c\x45\xd7\x35\xf8\x0e\x9f\xd9\xa4\xdd\xd8\x87\x68\xba\xe8\xa6\x29\x34\x17\x70\x6c\xc5\x31\xed\xd8\x53\x49\x84\x84\x61\xee\x5e\xe2\x21\x9b\x92\xd5\x7b\x3c\x09\xc9\x7b\x7c\x86\xf8\x1d\x32\x61\x9e\xe1\xee\xbe\x2c\x88\xad\x6a\x28\x55\xa0\x27\x82\x5e\x27\x12\x1c\x1b\x43\xe2\x89\xc6\x80\xcd\x3c\xdf\xd5\x7d\xad\x5c\x96\x8d\xb3\x66\x7a\x78\xcd\x1a\x6b\xbc\xc7\x6b\x4b\x84\x4c\x95\x38\x28\x25\x1c\xae\xb8\x34\x87\xed\x0e\x1f\xbb\x3d\xde\xb6\xef\x7b\x77\x5b\x2c\xe3\xd4\x58\xf1\x6c\xcd\xd3\x90\x82\x86\x43\x10\xf2\xf2\xfb\x33\xfc\x0f\x3e\x71\x39\x13\xf7\x4e\x35\xb6\x54\x6a\xf6\x51\xce\x6b\x60\x6e\x2c\x5d\x8f\xa3\x76\x32\x65\xba\x4c\x5d\x45\xb9\xd4\xbc\x8e\xb5\x8c\x34\x58\xf3\x72\xfb\xfd\x17\x00\x00\xff\xff\x9c\xf1\x44\x24\x10\x01\x00\x00"
-
-func xo_packageGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_xo_packageGoTpl,
-		"xo_package.go.tpl",
-	)
-}
-
-func xo_packageGoTpl() (*asset, error) {
-	bytes, err := xo_packageGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "xo_package.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"mssql.foreignkey.go.tpl": mssqlForeignkeyGoTpl,
-	"mssql.index.go.tpl": mssqlIndexGoTpl,
-	"mssql.query.go.tpl": mssqlQueryGoTpl,
-	"mssql.querytype.go.tpl": mssqlQuerytypeGoTpl,
-	"mssql.type.go.tpl": mssqlTypeGoTpl,
-	"mysql.enum.go.tpl": mysqlEnumGoTpl,
-	"mysql.foreignkey.go.tpl": mysqlForeignkeyGoTpl,
-	"mysql.index.go.tpl": mysqlIndexGoTpl,
-	"mysql.proc.go.tpl": mysqlProcGoTpl,
-	"mysql.query.go.tpl": mysqlQueryGoTpl,
-	"mysql.querytype.go.tpl": mysqlQuerytypeGoTpl,
-	"mysql.type.go.tpl": mysqlTypeGoTpl,
-	"oracle.foreignkey.go.tpl": oracleForeignkeyGoTpl,
-	"oracle.index.go.tpl": oracleIndexGoTpl,
-	"oracle.query.go.tpl": oracleQueryGoTpl,
-	"oracle.querytype.go.tpl": oracleQuerytypeGoTpl,
-	"oracle.type.go.tpl": oracleTypeGoTpl,
-	"postgres.enum.go.tpl": postgresEnumGoTpl,
-	"postgres.foreignkey.go.tpl": postgresForeignkeyGoTpl,
-	"postgres.index.go.tpl": postgresIndexGoTpl,
-	"postgres.proc.go.tpl": postgresProcGoTpl,
-	"postgres.query.go.tpl": postgresQueryGoTpl,
-	"postgres.querytype.go.tpl": postgresQuerytypeGoTpl,
-	"postgres.type.go.tpl": postgresTypeGoTpl,
-	"sqlite3.foreignkey.go.tpl": sqlite3ForeignkeyGoTpl,
-	"sqlite3.index.go.tpl": sqlite3IndexGoTpl,
-	"sqlite3.query.go.tpl": sqlite3QueryGoTpl,
-	"sqlite3.querytype.go.tpl": sqlite3QuerytypeGoTpl,
-	"sqlite3.type.go.tpl": sqlite3TypeGoTpl,
-	"xo_db.go.tpl": xo_dbGoTpl,
-	"xo_package.go.tpl": xo_packageGoTpl,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"mssql.foreignkey.go.tpl": &bintree{mssqlForeignkeyGoTpl, map[string]*bintree{}},
-	"mssql.index.go.tpl": &bintree{mssqlIndexGoTpl, map[string]*bintree{}},
-	"mssql.query.go.tpl": &bintree{mssqlQueryGoTpl, map[string]*bintree{}},
-	"mssql.querytype.go.tpl": &bintree{mssqlQuerytypeGoTpl, map[string]*bintree{}},
-	"mssql.type.go.tpl": &bintree{mssqlTypeGoTpl, map[string]*bintree{}},
-	"mysql.enum.go.tpl": &bintree{mysqlEnumGoTpl, map[string]*bintree{}},
-	"mysql.foreignkey.go.tpl": &bintree{mysqlForeignkeyGoTpl, map[string]*bintree{}},
-	"mysql.index.go.tpl": &bintree{mysqlIndexGoTpl, map[string]*bintree{}},
-	"mysql.proc.go.tpl": &bintree{mysqlProcGoTpl, map[string]*bintree{}},
-	"mysql.query.go.tpl": &bintree{mysqlQueryGoTpl, map[string]*bintree{}},
-	"mysql.querytype.go.tpl": &bintree{mysqlQuerytypeGoTpl, map[string]*bintree{}},
-	"mysql.type.go.tpl": &bintree{mysqlTypeGoTpl, map[string]*bintree{}},
-	"oracle.foreignkey.go.tpl": &bintree{oracleForeignkeyGoTpl, map[string]*bintree{}},
-	"oracle.index.go.tpl": &bintree{oracleIndexGoTpl, map[string]*bintree{}},
-	"oracle.query.go.tpl": &bintree{oracleQueryGoTpl, map[string]*bintree{}},
-	"oracle.querytype.go.tpl": &bintree{oracleQuerytypeGoTpl, map[string]*bintree{}},
-	"oracle.type.go.tpl": &bintree{oracleTypeGoTpl, map[string]*bintree{}},
-	"postgres.enum.go.tpl": &bintree{postgresEnumGoTpl, map[string]*bintree{}},
-	"postgres.foreignkey.go.tpl": &bintree{postgresForeignkeyGoTpl, map[string]*bintree{}},
-	"postgres.index.go.tpl": &bintree{postgresIndexGoTpl, map[string]*bintree{}},
-	"postgres.proc.go.tpl": &bintree{postgresProcGoTpl, map[string]*bintree{}},
-	"postgres.query.go.tpl": &bintree{postgresQueryGoTpl, map[string]*bintree{}},
-	"postgres.querytype.go.tpl": &bintree{postgresQuerytypeGoTpl, map[string]*bintree{}},
-	"postgres.type.go.tpl": &bintree{postgresTypeGoTpl, map[string]*bintree{}},
-	"sqlite3.foreignkey.go.tpl": &bintree{sqlite3ForeignkeyGoTpl, map[string]*bintree{}},
-	"sqlite3.index.go.tpl": &bintree{sqlite3IndexGoTpl, map[string]*bintree{}},
-	"sqlite3.query.go.tpl": &bintree{sqlite3QueryGoTpl, map[string]*bintree{}},
-	"sqlite3.querytype.go.tpl": &bintree{sqlite3QuerytypeGoTpl, map[string]*bintree{}},
-	"sqlite3.type.go.tpl": &bintree{sqlite3TypeGoTpl, map[string]*bintree{}},
-	"xo_db.go.tpl": &bintree{xo_dbGoTpl, map[string]*bintree{}},
-	"xo_package.go.tpl": &bintree{xo_packageGoTpl, map[string]*bintree{}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-